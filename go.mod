@@ -0,0 +1,3 @@
+module github.com/lyuangg/yuango
+
+go 1.21
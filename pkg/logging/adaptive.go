@@ -0,0 +1,111 @@
+package logging
+
+import (
+	"context"
+	"sync"
+	"time"
+)
+
+// AdaptiveSamplingConfig controls the throughput-driven sampler.
+type AdaptiveSamplingConfig struct {
+	// TargetPerSecond is the volume the sampler tries to stay under;
+	// 0 means 1000 records/second.
+	TargetPerSecond int
+	// MaxThinning caps how aggressive sampling may get (1-in-N);
+	// 0 means 1000.
+	MaxThinning int
+	// MaxLevel bounds sampling: records above it (warnings and errors by
+	// default) always pass regardless of load.
+	MaxLevel Level
+}
+
+// AdaptiveDecision reports one sampling adjustment.
+type AdaptiveDecision struct {
+	// Time is when the window closed.
+	Time time.Time
+	// Rate is the observed records/second in the closed window.
+	Rate int
+	// Thinning is the new keep ratio: 1 keeps everything, N keeps 1-in-N.
+	Thinning int
+}
+
+// AdaptiveSampler watches throughput and tightens Debug/Info sampling
+// when volume crosses the target, relaxing again as load drops. Each
+// adjustment is published on Decisions so operators can see what the
+// sampler is doing.
+type AdaptiveSampler struct {
+	cfg       AdaptiveSamplingConfig
+	mu        sync.Mutex
+	window    time.Time
+	seen      int
+	thinning  int
+	kept      int
+	decisions chan AdaptiveDecision
+}
+
+// NewAdaptiveSampler returns a sampler with thinning initially off.
+func NewAdaptiveSampler(cfg AdaptiveSamplingConfig) *AdaptiveSampler {
+	if cfg.TargetPerSecond == 0 {
+		cfg.TargetPerSecond = 1000
+	}
+	if cfg.MaxThinning == 0 {
+		cfg.MaxThinning = 1000
+	}
+	if cfg.MaxLevel == 0 {
+		cfg.MaxLevel = LevelInfo
+	}
+	return &AdaptiveSampler{
+		cfg:       cfg,
+		thinning:  1,
+		decisions: make(chan AdaptiveDecision, 16),
+	}
+}
+
+// Decisions returns the channel sampling adjustments are published on.
+// Sends never block; undrained decisions are dropped.
+func (s *AdaptiveSampler) Decisions() <-chan AdaptiveDecision {
+	return s.decisions
+}
+
+// Hook returns the sampler as a record hook.
+func (s *AdaptiveSampler) Hook() Hook {
+	return func(ctx context.Context, r Record) (Record, bool) {
+		s.mu.Lock()
+		defer s.mu.Unlock()
+		if r.Time.Sub(s.window) >= time.Second {
+			s.adjustLocked(r.Time)
+		}
+		s.seen++
+		if r.Level > s.cfg.MaxLevel || s.thinning <= 1 {
+			return r, true
+		}
+		s.kept++
+		if s.kept%s.thinning == 0 {
+			return r, true
+		}
+		return r, false
+	}
+}
+
+// adjustLocked closes the current window and recomputes the thinning
+// ratio from the observed rate. Callers hold s.mu.
+func (s *AdaptiveSampler) adjustLocked(now time.Time) {
+	rate := s.seen
+	s.window = now
+	s.seen = 0
+	s.kept = 0
+	thinning := 1
+	if rate > s.cfg.TargetPerSecond {
+		thinning = (rate + s.cfg.TargetPerSecond - 1) / s.cfg.TargetPerSecond
+		if thinning > s.cfg.MaxThinning {
+			thinning = s.cfg.MaxThinning
+		}
+	}
+	if thinning != s.thinning {
+		s.thinning = thinning
+		select {
+		case s.decisions <- AdaptiveDecision{Time: now, Rate: rate, Thinning: thinning}:
+		default:
+		}
+	}
+}
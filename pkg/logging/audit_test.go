@@ -0,0 +1,84 @@
+package logging
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func writeAuditFile(t *testing.T, key []byte) string {
+	t.Helper()
+	path := filepath.Join(t.TempDir(), "audit.log")
+	f, err := os.Create(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer f.Close()
+	a := NewAuditLogger(f, key)
+	if err := a.Info("user created", "user", "alice"); err != nil {
+		t.Fatal(err)
+	}
+	if err := a.Warn("permission granted", "user", "alice", "role", "admin"); err != nil {
+		t.Fatal(err)
+	}
+	if err := a.Error("permission revoked", "user", "alice"); err != nil {
+		t.Fatal(err)
+	}
+	return path
+}
+
+func TestVerifyAuditFile(t *testing.T) {
+	key := []byte("audit-key")
+	path := writeAuditFile(t, key)
+	if err := VerifyAuditFile(path, key); err != nil {
+		t.Fatalf("VerifyAuditFile on intact file: %v", err)
+	}
+}
+
+func TestVerifyAuditFileDetectsEdit(t *testing.T) {
+	path := writeAuditFile(t, nil)
+	data, err := os.ReadFile(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	tampered := strings.Replace(string(data), "permission granted", "permission DENIED", 1)
+	if tampered == string(data) {
+		t.Fatal("tamper target not found")
+	}
+	if err := os.WriteFile(path, []byte(tampered), 0o644); err != nil {
+		t.Fatal(err)
+	}
+	err = VerifyAuditFile(path, nil)
+	if err == nil || !strings.Contains(err.Error(), "hash mismatch") {
+		t.Fatalf("VerifyAuditFile after edit = %v, want hash mismatch", err)
+	}
+}
+
+func TestVerifyAuditFileDetectsDeletedLine(t *testing.T) {
+	path := writeAuditFile(t, nil)
+	data, err := os.ReadFile(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	lines := strings.SplitAfter(string(data), "\n")
+	if len(lines) < 3 {
+		t.Fatalf("expected at least 3 lines, got %d", len(lines))
+	}
+	// Drop the middle entry; the next entry's Prev no longer matches.
+	if err := os.WriteFile(path, []byte(lines[0]+lines[2]), 0o644); err != nil {
+		t.Fatal(err)
+	}
+	err = VerifyAuditFile(path, nil)
+	if err == nil || !strings.Contains(err.Error(), "chain broken") {
+		t.Fatalf("VerifyAuditFile after deletion = %v, want chain broken", err)
+	}
+}
+
+func TestVerifyAuditFileWrongKey(t *testing.T) {
+	path := writeAuditFile(t, []byte("right-key"))
+	err := VerifyAuditFile(path, []byte("wrong-key"))
+	if err == nil || !strings.Contains(err.Error(), "mac mismatch") {
+		t.Fatalf("VerifyAuditFile with wrong key = %v, want mac mismatch", err)
+	}
+}
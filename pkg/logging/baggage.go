@@ -0,0 +1,107 @@
+package logging
+
+import (
+	"context"
+	"log/slog"
+	"sort"
+	"sync"
+	"sync/atomic"
+)
+
+// ctxMetadataKey keys the generic correlation metadata in a context.
+type ctxMetadataKey struct{}
+
+var metadataExtractorOnce sync.Once
+
+// ContextWithMetadata returns a context carrying correlation metadata.
+// Every record logged with the context (through WithContext or the
+// pipeline) automatically gets the map's entries as attributes, merged
+// over any metadata already present, so keys set at the edge of the
+// system follow the request downstream.
+func ContextWithMetadata(ctx context.Context, md map[string]string) context.Context {
+	metadataExtractorOnce.Do(func() {
+		RegisterContextExtractor(metadataAttrs)
+	})
+	merged := map[string]string{}
+	for k, v := range MetadataFromContext(ctx) {
+		merged[k] = v
+	}
+	for k, v := range md {
+		merged[k] = v
+	}
+	return context.WithValue(ctx, ctxMetadataKey{}, merged)
+}
+
+// MetadataFromContext returns the metadata carried by ctx, or nil.
+func MetadataFromContext(ctx context.Context) map[string]string {
+	if ctx == nil {
+		return nil
+	}
+	md, _ := ctx.Value(ctxMetadataKey{}).(map[string]string)
+	return md
+}
+
+// metadataAttrs surfaces context metadata as record attributes, in
+// deterministic key order.
+func metadataAttrs(ctx context.Context) []slog.Attr {
+	md := MetadataFromContext(ctx)
+	if len(md) == 0 {
+		return nil
+	}
+	keys := make([]string, 0, len(md))
+	for k := range md {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	attrs := make([]slog.Attr, 0, len(keys))
+	for _, k := range keys {
+		attrs = append(attrs, slog.String(k, md[k]))
+	}
+	return attrs
+}
+
+// baggageExtractor holds the installed OpenTelemetry baggage bridge.
+var baggageExtractor atomic.Value // of func(context.Context) map[string]string
+
+var baggageExtractorOnce sync.Once
+
+// SetBaggageExtractor installs the function that reads OpenTelemetry
+// baggage from a context, so cross-service correlation keys set upstream
+// appear on every record downstream. Bridge it to the OTel SDK once at
+// startup:
+//
+//	logging.SetBaggageExtractor(func(ctx context.Context) map[string]string {
+//		out := map[string]string{}
+//		for _, m := range baggage.FromContext(ctx).Members() {
+//			out[m.Key()] = m.Value()
+//		}
+//		return out
+//	})
+func SetBaggageExtractor(fn func(ctx context.Context) map[string]string) {
+	baggageExtractor.Store(fn)
+	baggageExtractorOnce.Do(func() {
+		RegisterContextExtractor(baggageAttrs)
+	})
+}
+
+// baggageAttrs surfaces baggage members as attributes.
+func baggageAttrs(ctx context.Context) []slog.Attr {
+	fn, _ := baggageExtractor.Load().(func(ctx context.Context) map[string]string)
+	if fn == nil {
+		return nil
+	}
+	md := fn(ctx)
+	if len(md) == 0 {
+		return nil
+	}
+	keys := make([]string, 0, len(md))
+	for k := range md {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	attrs := make([]slog.Attr, 0, len(keys))
+	for _, k := range keys {
+		attrs = append(attrs, slog.String(k, md[k]))
+	}
+	return attrs
+}
@@ -0,0 +1,142 @@
+package logging
+
+import (
+	"bytes"
+	"context"
+	"io"
+	"sync"
+	"time"
+)
+
+const (
+	// defaultBatchDelay is how long small records are coalesced before
+	// being written out together.
+	defaultBatchDelay = 5 * time.Millisecond
+	// defaultBatchMaxSize flushes early once this many buffered bytes
+	// accumulate.
+	defaultBatchMaxSize = 64 * 1024
+)
+
+// BatchWriter coalesces records accumulated within a short window into a
+// single write on the underlying writer, cutting per-record syscalls under
+// heavy parallel logging. Pair with Flush or Close before process exit so
+// the tail of the buffer reaches the writer.
+type BatchWriter struct {
+	out     io.Writer
+	delay   time.Duration
+	maxSize int
+	mu      sync.Mutex
+	buf     bytes.Buffer
+	timer   *time.Timer
+	lastErr error
+	closed  bool
+}
+
+// BatchOption configures a BatchWriter.
+type BatchOption func(*BatchWriter)
+
+// WithBatchDelay sets the coalescing window; records wait at most this
+// long before being written.
+func WithBatchDelay(d time.Duration) BatchOption {
+	return func(b *BatchWriter) {
+		if d > 0 {
+			b.delay = d
+		}
+	}
+}
+
+// WithBatchMaxSize sets the buffered-byte threshold that triggers an
+// immediate flush regardless of the delay.
+func WithBatchMaxSize(n int) BatchOption {
+	return func(b *BatchWriter) {
+		if n > 0 {
+			b.maxSize = n
+		}
+	}
+}
+
+// NewBatchWriter wraps out in a coalescing buffer.
+func NewBatchWriter(out io.Writer, opts ...BatchOption) *BatchWriter {
+	b := &BatchWriter{out: out, delay: defaultBatchDelay, maxSize: defaultBatchMaxSize}
+	for _, opt := range opts {
+		opt(b)
+	}
+	return b
+}
+
+// Write buffers p and schedules a flush. Errors from the underlying
+// writer surface on the next Write or Flush after they occur.
+func (b *BatchWriter) Write(p []byte) (int, error) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	if err := b.lastErr; err != nil {
+		b.lastErr = nil
+		return 0, err
+	}
+	b.buf.Write(p)
+	if b.buf.Len() >= b.maxSize {
+		return len(p), b.flushLocked()
+	}
+	if b.timer == nil {
+		b.timer = time.AfterFunc(b.delay, b.timedFlush)
+	}
+	return len(p), nil
+}
+
+// timedFlush runs when the coalescing window closes.
+func (b *BatchWriter) timedFlush() {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	if b.closed {
+		return
+	}
+	if err := b.flushLocked(); err != nil {
+		b.lastErr = err
+	}
+}
+
+// flushLocked writes the buffered bytes in one call. The caller must hold
+// b.mu.
+func (b *BatchWriter) flushLocked() error {
+	if b.timer != nil {
+		b.timer.Stop()
+		b.timer = nil
+	}
+	if b.buf.Len() == 0 {
+		return nil
+	}
+	_, err := b.out.Write(b.buf.Bytes())
+	b.buf.Reset()
+	return err
+}
+
+// Flush writes any buffered records and flushes the underlying writer.
+func (b *BatchWriter) Flush(ctx context.Context) error {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	if err := b.flushLocked(); err != nil {
+		return err
+	}
+	switch out := b.out.(type) {
+	case Syncer:
+		return out.Flush(ctx)
+	case interface{ Sync() error }:
+		return out.Sync()
+	}
+	return nil
+}
+
+// Close flushes the buffer and closes the underlying writer when it is an
+// io.Closer.
+func (b *BatchWriter) Close() error {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	b.closed = true
+	err := b.flushLocked()
+	if c, ok := b.out.(io.Closer); ok {
+		if cerr := c.Close(); err == nil {
+			err = cerr
+		}
+	}
+	return err
+}
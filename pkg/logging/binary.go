@@ -0,0 +1,243 @@
+package logging
+
+import (
+	"bufio"
+	"context"
+	"encoding/binary"
+	"fmt"
+	"io"
+	"log/slog"
+	"math"
+	"strings"
+	"sync"
+	"time"
+)
+
+// binaryVersion is the version byte leading every binary record.
+const binaryVersion = 1
+
+// Binary value type tags.
+const (
+	binString byte = iota
+	binInt64
+	binUint64
+	binFloat64
+	binBool
+)
+
+// BinaryHandler encodes records in a compact length-prefixed binary
+// format, cutting storage and encode CPU versus JSON for very high-volume
+// services. Files written with it can be decoded back into structured
+// records with BinaryReader.
+type BinaryHandler struct {
+	w      io.Writer
+	mu     *sync.Mutex
+	level  slog.Leveler
+	attrs  []slog.Attr
+	groups []string
+}
+
+// NewBinaryHandler returns a BinaryHandler writing to w at the given
+// minimum level (LevelInfo when nil).
+func NewBinaryHandler(w io.Writer, level slog.Leveler) *BinaryHandler {
+	return &BinaryHandler{w: w, mu: &sync.Mutex{}, level: level}
+}
+
+// Enabled implements slog.Handler.
+func (h *BinaryHandler) Enabled(_ context.Context, level slog.Level) bool {
+	min := slog.Level(LevelInfo)
+	if h.level != nil {
+		min = h.level.Level()
+	}
+	return level >= min
+}
+
+// Handle implements slog.Handler.
+func (h *BinaryHandler) Handle(_ context.Context, r slog.Record) error {
+	var payload []byte
+	payload = append(payload, binaryVersion)
+	payload = binary.AppendVarint(payload, r.Time.UnixNano())
+	payload = binary.AppendVarint(payload, int64(r.Level))
+	payload = appendBinaryString(payload, r.Message)
+
+	attrs := make([]slog.Attr, 0, len(h.attrs)+r.NumAttrs())
+	attrs = append(attrs, h.attrs...)
+	prefix := strings.Join(h.groups, ".")
+	r.Attrs(func(a slog.Attr) bool {
+		if prefix != "" {
+			a.Key = prefix + "." + a.Key
+		}
+		attrs = append(attrs, a)
+		return true
+	})
+	payload = binary.AppendUvarint(payload, uint64(len(attrs)))
+	for _, a := range attrs {
+		payload = appendBinaryString(payload, a.Key)
+		payload = appendBinaryValue(payload, a.Value)
+	}
+
+	frame := binary.AppendUvarint(nil, uint64(len(payload)))
+	frame = append(frame, payload...)
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	_, err := h.w.Write(frame)
+	return err
+}
+
+// WithAttrs implements slog.Handler.
+func (h *BinaryHandler) WithAttrs(attrs []slog.Attr) slog.Handler {
+	clone := *h
+	clone.attrs = append(append([]slog.Attr{}, h.attrs...), qualifyAll(h.groups, attrs)...)
+	return &clone
+}
+
+// WithGroup implements slog.Handler.
+func (h *BinaryHandler) WithGroup(name string) slog.Handler {
+	if name == "" {
+		return h
+	}
+	clone := *h
+	clone.groups = append(append([]string{}, h.groups...), name)
+	return &clone
+}
+
+func appendBinaryString(b []byte, s string) []byte {
+	b = binary.AppendUvarint(b, uint64(len(s)))
+	return append(b, s...)
+}
+
+func appendBinaryValue(b []byte, v slog.Value) []byte {
+	v = v.Resolve()
+	switch v.Kind() {
+	case slog.KindInt64:
+		b = append(b, binInt64)
+		return binary.AppendVarint(b, v.Int64())
+	case slog.KindUint64:
+		b = append(b, binUint64)
+		return binary.AppendUvarint(b, v.Uint64())
+	case slog.KindFloat64:
+		b = append(b, binFloat64)
+		return binary.AppendUvarint(b, math.Float64bits(v.Float64()))
+	case slog.KindBool:
+		b = append(b, binBool)
+		if v.Bool() {
+			return append(b, 1)
+		}
+		return append(b, 0)
+	default:
+		b = append(b, binString)
+		return appendBinaryString(b, v.String())
+	}
+}
+
+// BinaryRecord is one decoded record from a binary log file.
+type BinaryRecord struct {
+	Time    time.Time
+	Level   Level
+	Message string
+	Attrs   []slog.Attr
+}
+
+// BinaryReader decodes files written by BinaryHandler.
+type BinaryReader struct {
+	r *bufio.Reader
+}
+
+// NewBinaryReader returns a reader decoding records from r.
+func NewBinaryReader(r io.Reader) *BinaryReader {
+	return &BinaryReader{r: bufio.NewReader(r)}
+}
+
+// Next returns the next record, or io.EOF at the end of the stream.
+func (br *BinaryReader) Next() (BinaryRecord, error) {
+	var rec BinaryRecord
+	size, err := binary.ReadUvarint(br.r)
+	if err != nil {
+		return rec, err
+	}
+	payload := make([]byte, size)
+	if _, err := io.ReadFull(br.r, payload); err != nil {
+		return rec, err
+	}
+	d := &binaryDecoder{buf: payload}
+	if version := d.byte(); version != binaryVersion {
+		return rec, fmt.Errorf("logging: unsupported binary record version %d", version)
+	}
+	rec.Time = time.Unix(0, d.varint())
+	rec.Level = Level(d.varint())
+	rec.Message = d.string()
+	n := d.uvarint()
+	for i := uint64(0); i < n && d.err == nil; i++ {
+		key := d.string()
+		rec.Attrs = append(rec.Attrs, slog.Attr{Key: key, Value: d.value()})
+	}
+	return rec, d.err
+}
+
+// binaryDecoder walks a record payload, latching the first error.
+type binaryDecoder struct {
+	buf []byte
+	err error
+}
+
+func (d *binaryDecoder) byte() byte {
+	if d.err != nil || len(d.buf) == 0 {
+		d.fail()
+		return 0
+	}
+	b := d.buf[0]
+	d.buf = d.buf[1:]
+	return b
+}
+
+func (d *binaryDecoder) varint() int64 {
+	v, n := binary.Varint(d.buf)
+	if n <= 0 {
+		d.fail()
+		return 0
+	}
+	d.buf = d.buf[n:]
+	return v
+}
+
+func (d *binaryDecoder) uvarint() uint64 {
+	v, n := binary.Uvarint(d.buf)
+	if n <= 0 {
+		d.fail()
+		return 0
+	}
+	d.buf = d.buf[n:]
+	return v
+}
+
+func (d *binaryDecoder) string() string {
+	n := d.uvarint()
+	if d.err != nil || uint64(len(d.buf)) < n {
+		d.fail()
+		return ""
+	}
+	s := string(d.buf[:n])
+	d.buf = d.buf[n:]
+	return s
+}
+
+func (d *binaryDecoder) value() slog.Value {
+	switch d.byte() {
+	case binInt64:
+		return slog.Int64Value(d.varint())
+	case binUint64:
+		return slog.Uint64Value(d.uvarint())
+	case binFloat64:
+		return slog.Float64Value(math.Float64frombits(d.uvarint()))
+	case binBool:
+		return slog.BoolValue(d.byte() == 1)
+	default:
+		return slog.StringValue(d.string())
+	}
+}
+
+func (d *binaryDecoder) fail() {
+	if d.err == nil {
+		d.err = fmt.Errorf("logging: truncated binary record")
+	}
+}
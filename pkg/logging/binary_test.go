@@ -0,0 +1,111 @@
+package logging
+
+import (
+	"bytes"
+	"context"
+	"io"
+	"log/slog"
+	"strconv"
+	"testing"
+	"time"
+)
+
+func TestBinaryRoundTrip(t *testing.T) {
+	var buf bytes.Buffer
+	h := NewBinaryHandler(&buf, nil)
+
+	ts := time.Date(2024, 5, 1, 12, 30, 0, 123456789, time.UTC)
+	r := slog.NewRecord(ts, slog.Level(LevelWarn), "disk almost full", 0)
+	r.AddAttrs(
+		slog.String("mount", "/var"),
+		slog.Int("used_pct", 93),
+		slog.Uint64("bytes_free", 1<<30),
+		slog.Float64("ratio", 0.93),
+		slog.Bool("readonly", false),
+	)
+	if err := h.Handle(context.Background(), r); err != nil {
+		t.Fatalf("Handle: %v", err)
+	}
+
+	br := NewBinaryReader(&buf)
+	rec, err := br.Next()
+	if err != nil {
+		t.Fatalf("Next: %v", err)
+	}
+	if !rec.Time.Equal(ts) {
+		t.Errorf("Time = %v, want %v", rec.Time, ts)
+	}
+	if rec.Level != LevelWarn {
+		t.Errorf("Level = %v, want %v", rec.Level, LevelWarn)
+	}
+	if rec.Message != "disk almost full" {
+		t.Errorf("Message = %q", rec.Message)
+	}
+	want := map[string]string{
+		"mount":      "/var",
+		"used_pct":   "93",
+		"bytes_free": "1073741824",
+		"ratio":      "0.93",
+		"readonly":   "false",
+	}
+	if len(rec.Attrs) != len(want) {
+		t.Fatalf("got %d attrs, want %d", len(rec.Attrs), len(want))
+	}
+	for _, a := range rec.Attrs {
+		if got := a.Value.String(); got != want[a.Key] {
+			t.Errorf("attr %s = %q, want %q", a.Key, got, want[a.Key])
+		}
+	}
+	if _, err := br.Next(); err != io.EOF {
+		t.Errorf("Next at end = %v, want io.EOF", err)
+	}
+}
+
+func TestBinaryRoundTripMultipleRecordsAndGroups(t *testing.T) {
+	var buf bytes.Buffer
+	var h slog.Handler = NewBinaryHandler(&buf, nil)
+	h = h.WithAttrs([]slog.Attr{slog.String("app", "api")})
+	h = h.WithGroup("req")
+
+	for i, msg := range []string{"first", "second"} {
+		r := slog.NewRecord(time.Unix(int64(1700000000+i), 0), slog.Level(LevelInfo), msg, 0)
+		r.AddAttrs(slog.Int("seq", i))
+		if err := h.Handle(context.Background(), r); err != nil {
+			t.Fatalf("Handle %q: %v", msg, err)
+		}
+	}
+
+	br := NewBinaryReader(&buf)
+	for i, msg := range []string{"first", "second"} {
+		rec, err := br.Next()
+		if err != nil {
+			t.Fatalf("Next %d: %v", i, err)
+		}
+		if rec.Message != msg {
+			t.Errorf("record %d message = %q, want %q", i, rec.Message, msg)
+		}
+		got := map[string]string{}
+		for _, a := range rec.Attrs {
+			got[a.Key] = a.Value.String()
+		}
+		if got["app"] != "api" {
+			t.Errorf("record %d app = %q, want api", i, got["app"])
+		}
+		if want := strconv.Itoa(i); got["req.seq"] != want {
+			t.Errorf("record %d req.seq = %q, want %q", i, got["req.seq"], want)
+		}
+	}
+}
+
+func TestBinaryReaderTruncated(t *testing.T) {
+	var buf bytes.Buffer
+	h := NewBinaryHandler(&buf, nil)
+	r := slog.NewRecord(time.Now(), slog.Level(LevelInfo), "hello", 0)
+	if err := h.Handle(context.Background(), r); err != nil {
+		t.Fatal(err)
+	}
+	truncated := buf.Bytes()[:buf.Len()-2]
+	if _, err := NewBinaryReader(bytes.NewReader(truncated)).Next(); err == nil {
+		t.Fatal("Next on truncated stream succeeded")
+	}
+}
@@ -0,0 +1,77 @@
+package logging
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"log/slog"
+	"strings"
+	"testing"
+	"time"
+)
+
+func cefLine(t *testing.T, opts CEFOptions, r slog.Record) string {
+	t.Helper()
+	var buf bytes.Buffer
+	h := NewCEFHandler(&buf, opts)
+	if err := h.Handle(context.Background(), r); err != nil {
+		t.Fatalf("Handle: %v", err)
+	}
+	return buf.String()
+}
+
+func TestCEFLine(t *testing.T) {
+	ts := time.Date(2024, 5, 1, 12, 30, 0, 0, time.UTC)
+	r := slog.NewRecord(ts, slog.Level(LevelWarn), "login failed", 0)
+	r.AddAttrs(
+		slog.String("signature", "AUTH-401"),
+		slog.String("client_ip", "10.0.0.1"),
+	)
+	line := cefLine(t, CEFOptions{
+		Vendor: "acme", Product: "gateway", Version: "2.1",
+		ExtensionMap: map[string]string{"client_ip": "src"},
+	}, r)
+
+	want := fmt.Sprintf("CEF:0|acme|gateway|2.1|AUTH-401|login failed|6|src=10.0.0.1 rt=%d\n", ts.UnixMilli())
+	if line != want {
+		t.Errorf("line = %q\nwant   %q", line, want)
+	}
+}
+
+func TestCEFHeaderEscaping(t *testing.T) {
+	r := slog.NewRecord(time.Now(), slog.Level(LevelInfo), `pipe | and \ slash`, 0)
+	line := cefLine(t, CEFOptions{Vendor: "a|b"}, r)
+	if !strings.Contains(line, `|a\|b|`) {
+		t.Errorf("line %q does not escape the pipe in the vendor header", line)
+	}
+	if !strings.Contains(line, `|pipe \| and \\ slash|`) {
+		t.Errorf("line %q does not escape the event name", line)
+	}
+}
+
+func TestCEFExtensionEscaping(t *testing.T) {
+	r := slog.NewRecord(time.Now(), slog.Level(LevelInfo), "msg", 0)
+	r.AddAttrs(slog.String("note", "a=b\\c\nd"))
+	line := cefLine(t, CEFOptions{}, r)
+	if !strings.Contains(line, `note=a\=b\\c\nd`) {
+		t.Errorf("line %q does not escape '=', '\\' and newline in the extension value", line)
+	}
+}
+
+func TestCEFSeverity(t *testing.T) {
+	tests := []struct {
+		level slog.Level
+		want  int
+	}{
+		{slog.Level(LevelDebug), 1},
+		{slog.Level(LevelInfo), 3},
+		{slog.Level(LevelWarn), 6},
+		{slog.Level(LevelError), 9},
+		{slog.Level(LevelError) + 4, 9},
+	}
+	for _, tt := range tests {
+		if got := cefSeverity(tt.level); got != tt.want {
+			t.Errorf("cefSeverity(%v) = %d, want %d", tt.level, got, tt.want)
+		}
+	}
+}
@@ -0,0 +1,178 @@
+package logging
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"log/slog"
+	"net/http"
+	"strings"
+	"sync"
+	"text/template"
+	"time"
+)
+
+// ChatAlertConfig controls the chat webhook alert hook.
+type ChatAlertConfig struct {
+	// URL is the incoming-webhook URL.
+	URL string
+	// Provider selects the payload shape: "slack" (default), "dingtalk"
+	// or "feishu".
+	Provider string
+	// MinLevel is the lowest level forwarded; nil means LevelError, so a
+	// MinLevel of LevelInfo is expressible.
+	MinLevel slog.Leveler
+	// MaxPerMinute caps forwarded alerts so an error burst pings on-call
+	// once per record budget, not once per record; 0 means 10. Suppressed
+	// records still reach the normal output.
+	MaxPerMinute int
+	// Template renders the message text from the record; fields are
+	// .Time, .Level, .Message and .Attrs (a map). Empty means
+	// "[{{.Level}}] {{.Message}}" with attributes appended.
+	Template string
+}
+
+// ChatAlertHook forwards high-severity records to a Slack, DingTalk or
+// Feishu webhook, so on-call hears about error bursts without a separate
+// alerting pipeline. Records always pass through to the normal output;
+// webhook failures are dropped silently.
+type ChatAlertHook struct {
+	cfg    ChatAlertConfig
+	tmpl   *template.Template
+	client *http.Client
+
+	mu          sync.Mutex
+	windowStart time.Time
+	sent        int
+	wg          sync.WaitGroup
+}
+
+// chatAlertData is the template context for one alert.
+type chatAlertData struct {
+	Time    time.Time
+	Level   string
+	Message string
+	Attrs   map[string]any
+}
+
+// NewChatAlertHook returns the hook.
+func NewChatAlertHook(cfg ChatAlertConfig) (*ChatAlertHook, error) {
+	if cfg.URL == "" {
+		return nil, fmt.Errorf("logging: chat alert requires a webhook URL")
+	}
+	switch cfg.Provider {
+	case "", "slack", "dingtalk", "feishu":
+	default:
+		return nil, fmt.Errorf("logging: unknown chat provider %q", cfg.Provider)
+	}
+	if cfg.MinLevel == nil {
+		cfg.MinLevel = LevelError
+	}
+	if cfg.MaxPerMinute == 0 {
+		cfg.MaxPerMinute = 10
+	}
+	var tmpl *template.Template
+	if cfg.Template != "" {
+		var err error
+		tmpl, err = template.New("chatalert").Parse(cfg.Template)
+		if err != nil {
+			return nil, fmt.Errorf("logging: bad chat alert template: %w", err)
+		}
+	}
+	return &ChatAlertHook{
+		cfg:    cfg,
+		tmpl:   tmpl,
+		client: &http.Client{Timeout: 5 * time.Second},
+	}, nil
+}
+
+// Hook returns the forwarding hook for AddHook or Config.Hooks.
+func (h *ChatAlertHook) Hook() Hook {
+	return func(ctx context.Context, r Record) (Record, bool) {
+		if r.Level >= h.cfg.MinLevel.Level() && h.allow(r.Time) {
+			h.wg.Add(1)
+			go h.send(r)
+		}
+		return r, true
+	}
+}
+
+// allow applies the per-minute cap.
+func (h *ChatAlertHook) allow(now time.Time) bool {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	if now.Sub(h.windowStart) >= time.Minute {
+		h.windowStart = now
+		h.sent = 0
+	}
+	if h.sent >= h.cfg.MaxPerMinute {
+		return false
+	}
+	h.sent++
+	return true
+}
+
+// send posts one alert in the provider's payload shape.
+func (h *ChatAlertHook) send(r Record) {
+	defer h.wg.Done()
+	text := h.render(r)
+	var payload map[string]any
+	switch h.cfg.Provider {
+	case "dingtalk":
+		payload = map[string]any{"msgtype": "text", "text": map[string]any{"content": text}}
+	case "feishu":
+		payload = map[string]any{"msg_type": "text", "content": map[string]any{"text": text}}
+	default:
+		payload = map[string]any{"text": text}
+	}
+	body, err := json.Marshal(payload)
+	if err != nil {
+		return
+	}
+	resp, err := h.client.Post(h.cfg.URL, "application/json", bytes.NewReader(body))
+	if err != nil {
+		return
+	}
+	resp.Body.Close()
+}
+
+// render produces the message text for r.
+func (h *ChatAlertHook) render(r Record) string {
+	if h.tmpl != nil {
+		data := chatAlertData{Time: r.Time, Level: r.Level.String(), Message: r.Message}
+		if len(r.Attrs) > 0 {
+			data.Attrs = make(map[string]any, len(r.Attrs))
+			for _, a := range r.Attrs {
+				data.Attrs[a.Key] = a.Value.Resolve().Any()
+			}
+		}
+		var buf bytes.Buffer
+		if err := h.tmpl.Execute(&buf, data); err == nil {
+			return buf.String()
+		}
+	}
+	var b strings.Builder
+	fmt.Fprintf(&b, "[%s] %s", r.Level, r.Message)
+	for _, a := range r.Attrs {
+		fmt.Fprintf(&b, " %s=%s", a.Key, a.Value.Resolve())
+	}
+	return b.String()
+}
+
+// Flush waits for in-flight alerts, bounded by ctx.
+func (h *ChatAlertHook) Flush(ctx context.Context) error {
+	done := make(chan struct{})
+	go func() {
+		h.wg.Wait()
+		close(done)
+	}()
+	select {
+	case <-done:
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
+
+var _ Syncer = (*ChatAlertHook)(nil)
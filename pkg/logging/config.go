@@ -0,0 +1,190 @@
+package logging
+
+import "log/slog"
+
+// Config describes how a logger should be constructed. The zero value is a
+// usable configuration writing text records to stdout at Info level.
+type Config struct {
+	// Level is the minimum level name: "debug", "info", "warn" or "error".
+	Level string `json:"level"`
+
+	// Format selects the record encoding: "text" (default) or "json".
+	Format string `json:"format"`
+
+	// Output is the destination: "stdout" (default), "stderr", "journald"
+	// (the systemd journal via its native protocol) or a file path.
+	// Several comma-separated destinations fan out to all of them, e.g.
+	// "stdout,/var/log/app.log".
+	Output string `json:"output"`
+
+	// Outputs configures several destinations with their own format and
+	// minimum level each, e.g. pretty text to stdout at debug plus a JSON
+	// daily file at info. When set it takes precedence over Output; every
+	// other field of this Config is inherited by each entry.
+	Outputs []OutputConfig `json:"outputs"`
+
+	// Rotate enables daily rotation when Output is a file path.
+	Rotate bool `json:"rotate"`
+
+	// MaxDays is how many days of rotated files to keep; 0 keeps all.
+	MaxDays int `json:"max_days"`
+
+	// Symlink keeps Output itself as a symlink pointing at the current
+	// dated file when rotation is enabled.
+	Symlink bool `json:"symlink"`
+
+	// DateFormat is the time layout for the date suffix in rotated file
+	// names; empty means "2006-01-02".
+	DateFormat string `json:"date_format"`
+
+	// UseUTC computes the rotation boundary and filename dates in UTC
+	// instead of local time.
+	UseUTC bool `json:"use_utc"`
+
+	// RotateAt moves the daily rotation boundary to the given "HH:MM"
+	// wall-clock time; empty rotates at midnight.
+	RotateAt string `json:"rotate_at"`
+
+	// TimeFormat controls how record timestamps are rendered: "unix",
+	// "unix-ms" (integer seconds/milliseconds), or a Go time layout such
+	// as time.RFC3339Nano. Empty keeps slog's default.
+	TimeFormat string `json:"time_format"`
+
+	// TimeField renames the built-in time key, e.g. "@timestamp".
+	TimeField string `json:"time_field"`
+
+	// OTLPEndpoint is the collector base URL for the "otlp" format, e.g.
+	// "http://localhost:4318".
+	OTLPEndpoint string `json:"otlp_endpoint"`
+
+	// OTLPHeaders are extra headers (e.g. authentication) sent with each
+	// OTLP export request.
+	OTLPHeaders map[string]string `json:"otlp_headers"`
+
+	// ReplaceAttr is passed through to slog's HandlerOptions so attributes
+	// can be renamed, dropped, or rewritten without a custom handler. Only
+	// settable programmatically.
+	ReplaceAttr func(groups []string, a slog.Attr) slog.Attr `json:"-"`
+
+	// AttrTransformer names a transformer registered with
+	// RegisterAttrTransformer, for configurations loaded from files.
+	AttrTransformer string `json:"attr_transformer"`
+
+	// FieldNames renames slog's built-in keys in the output, e.g.
+	// {"msg": "message", "time": "timestamp", "level": "severity"}.
+	FieldNames map[string]string `json:"field_names"`
+
+	// Source adds the file:line of the logging call site to each record.
+	Source bool `json:"source"`
+
+	// StackLevel attaches a formatted goroutine stack trace (as a "stack"
+	// attribute) to records at or above this level, e.g. "error". Empty
+	// disables stack capture.
+	StackLevel string `json:"stack_level"`
+
+	// StackDepth limits captured stack frames; 0 means 16.
+	StackDepth int `json:"stack_depth"`
+
+	// Template is the text/template line layout for the "template"
+	// format, e.g. "{{.Time}} [{{.Level}}] {{.Message}} {{.Attrs}}".
+	Template string `json:"template"`
+
+	// Dedup collapses consecutive identical records, emitting one copy
+	// with a "repeated" count when a different record arrives or the
+	// suppression window expires. See DedupHandler.
+	Dedup bool `json:"dedup"`
+
+	// Include keeps only records matching at least one rule; an empty
+	// list keeps everything. Applied before output.
+	Include []FilterRule `json:"include"`
+
+	// Exclude drops records matching any rule, e.g. health-check access
+	// logs or anything with component=noisy.
+	Exclude []FilterRule `json:"exclude"`
+
+	// Hooks run on every record before it reaches the handler; each can
+	// mutate or drop the record. Only settable programmatically.
+	Hooks []Hook `json:"-"`
+
+	// SyslogFacility is the facility code for the syslog formats and the
+	// "syslog://" output; 0 means local0.
+	SyslogFacility int `json:"syslog_facility"`
+
+	// SyslogTag overrides the APP-NAME / tag field for the syslog
+	// formats; empty uses the process name.
+	SyslogTag string `json:"syslog_tag"`
+
+	// Sanitize escapes embedded newlines and control characters in the
+	// message and string attribute values, keeping one record per line
+	// under the text format and blocking log injection.
+	Sanitize bool `json:"sanitize"`
+
+	// RedactKeys lists attribute keys whose values are always masked,
+	// case-insensitively, e.g. ["password", "token", "authorization"].
+	RedactKeys []string `json:"redact_keys"`
+
+	// RedactPatterns are regular expressions (credit cards, emails, ...)
+	// whose matches are masked inside string attribute values.
+	RedactPatterns []string `json:"redact_patterns"`
+
+	// RedactMask replaces redacted content; empty means "[REDACTED]".
+	RedactMask string `json:"redact_mask"`
+
+	// RichErrors serializes error-valued attributes as nested objects
+	// (message, cause chain, and stack when available) instead of flat
+	// strings. See ErrorValue.
+	RichErrors bool `json:"rich_errors"`
+
+	// LevelFormat controls how level values render: "upper" (slog's
+	// default, e.g. "WARN"), "lower" ("warn"), or "numeric" (the slog
+	// integer value).
+	LevelFormat string `json:"level_format"`
+
+	// LevelLabels overrides individual level labels, keyed by level name,
+	// e.g. {"warn": "WARNING", "error": "FATAL"}. Applied after
+	// LevelFormat.
+	LevelLabels map[string]string `json:"level_labels"`
+
+	// StaticFields adds a standard set of attributes to every record,
+	// resolved once at construction: "hostname", "pid", and — when the
+	// corresponding fields below are set — "app", "version" and "env".
+	StaticFields bool `json:"static_fields"`
+
+	// App is the service name emitted when StaticFields is on; empty
+	// defaults to the process name.
+	App string `json:"app"`
+
+	// AppVersion is the build version emitted when StaticFields is on.
+	AppVersion string `json:"app_version"`
+
+	// Env is the deployment environment (e.g. "production") emitted when
+	// StaticFields is on.
+	Env string `json:"env"`
+}
+
+// OutputConfig is one destination in Config.Outputs. Empty fields inherit
+// the base Config's value.
+type OutputConfig struct {
+	// Output is the destination, in the same forms Config.Output accepts.
+	Output string `json:"output"`
+
+	// Format is the record encoding for this destination.
+	Format string `json:"format"`
+
+	// Level is the minimum level for this destination. When empty the
+	// destination follows the logger's level, including SetLevel changes;
+	// when set it is fixed.
+	Level string `json:"level"`
+
+	// MaxLevel is the highest level for this destination, so records can
+	// be split by severity across entries — e.g. everything up to "info"
+	// to stdout, "warn" and above to an alerts file. Empty means no cap.
+	MaxLevel string `json:"max_level"`
+
+	// Match restricts this destination to records matching at least one
+	// rule, so one logger can feed several specialized files — e.g.
+	// {"attrs": {"component": "access"}} on an access.log entry, or
+	// {"attrs": {"audit": "true"}} on an audit sink. Attributes attached
+	// with With() count as well as per-call ones. Empty routes everything.
+	Match []FilterRule `json:"match"`
+}
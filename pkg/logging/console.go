@@ -0,0 +1,177 @@
+package logging
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"log/slog"
+	"strconv"
+	"strings"
+	"sync"
+)
+
+// ANSI escape sequences used by ConsoleHandler.
+const (
+	ansiReset  = "\x1b[0m"
+	ansiDim    = "\x1b[2m"
+	ansiRed    = "\x1b[31m"
+	ansiYellow = "\x1b[33m"
+	ansiGreen  = "\x1b[32m"
+	ansiCyan   = "\x1b[36m"
+)
+
+// ConsoleHandlerOptions configures a ConsoleHandler.
+type ConsoleHandlerOptions struct {
+	// Level is the minimum level; nil means LevelInfo.
+	Level slog.Leveler
+	// NoColor disables ANSI colors, e.g. when writing to a file.
+	NoColor bool
+	// MultiLine renders each attribute on its own indented line instead
+	// of key=value pairs appended to the message line.
+	MultiLine bool
+}
+
+// ConsoleHandler is a human-oriented slog.Handler for development:
+// colored levels, short timestamps and aligned fields, selectable through
+// Config.Format "console" (or "pretty").
+type ConsoleHandler struct {
+	opts   ConsoleHandlerOptions
+	mu     *sync.Mutex
+	w      io.Writer
+	attrs  []slog.Attr
+	groups []string
+}
+
+// NewConsoleHandler returns a ConsoleHandler writing to w.
+func NewConsoleHandler(w io.Writer, opts *ConsoleHandlerOptions) *ConsoleHandler {
+	h := &ConsoleHandler{w: w, mu: &sync.Mutex{}}
+	if opts != nil {
+		h.opts = *opts
+	}
+	return h
+}
+
+// Enabled implements slog.Handler.
+func (h *ConsoleHandler) Enabled(_ context.Context, level slog.Level) bool {
+	min := slog.Level(LevelInfo)
+	if h.opts.Level != nil {
+		min = h.opts.Level.Level()
+	}
+	return level >= min
+}
+
+// Handle implements slog.Handler.
+func (h *ConsoleHandler) Handle(_ context.Context, r slog.Record) error {
+	var b strings.Builder
+	if !r.Time.IsZero() {
+		b.WriteString(h.color(ansiDim))
+		b.WriteString(r.Time.Format("15:04:05.000"))
+		b.WriteString(h.color(ansiReset))
+		b.WriteByte(' ')
+	}
+	b.WriteString(h.levelTag(r.Level))
+	b.WriteByte(' ')
+	b.WriteString(r.Message)
+
+	attrs := make([]slog.Attr, 0, len(h.attrs)+r.NumAttrs())
+	attrs = append(attrs, h.attrs...)
+	r.Attrs(func(a slog.Attr) bool {
+		attrs = append(attrs, h.qualify(a))
+		return true
+	})
+	for _, a := range attrs {
+		if h.opts.MultiLine {
+			b.WriteString("\n    ")
+		} else {
+			b.WriteByte(' ')
+		}
+		b.WriteString(h.color(ansiCyan))
+		b.WriteString(a.Key)
+		b.WriteString(h.color(ansiReset))
+		b.WriteByte('=')
+		b.WriteString(formatConsoleValue(a.Value))
+	}
+	b.WriteByte('\n')
+
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	_, err := io.WriteString(h.w, b.String())
+	return err
+}
+
+// WithAttrs implements slog.Handler.
+func (h *ConsoleHandler) WithAttrs(attrs []slog.Attr) slog.Handler {
+	clone := *h
+	clone.attrs = append(append([]slog.Attr{}, h.attrs...), qualifyAll(h.groups, attrs)...)
+	return &clone
+}
+
+// WithGroup implements slog.Handler.
+func (h *ConsoleHandler) WithGroup(name string) slog.Handler {
+	if name == "" {
+		return h
+	}
+	clone := *h
+	clone.groups = append(append([]string{}, h.groups...), name)
+	return &clone
+}
+
+// qualify prefixes a's key with the open group names.
+func (h *ConsoleHandler) qualify(a slog.Attr) slog.Attr {
+	if len(h.groups) == 0 {
+		return a
+	}
+	a.Key = strings.Join(h.groups, ".") + "." + a.Key
+	return a
+}
+
+func qualifyAll(groups []string, attrs []slog.Attr) []slog.Attr {
+	if len(groups) == 0 {
+		return attrs
+	}
+	prefix := strings.Join(groups, ".") + "."
+	out := make([]slog.Attr, len(attrs))
+	for i, a := range attrs {
+		a.Key = prefix + a.Key
+		out[i] = a
+	}
+	return out
+}
+
+// levelTag renders a fixed-width, colored level label.
+func (h *ConsoleHandler) levelTag(level slog.Level) string {
+	var color, tag string
+	switch {
+	case level >= LevelError:
+		color, tag = ansiRed, "ERRO"
+	case level >= LevelWarn:
+		color, tag = ansiYellow, "WARN"
+	case level >= LevelInfo:
+		color, tag = ansiGreen, "INFO"
+	default:
+		color, tag = ansiCyan, "DEBU"
+	}
+	return h.color(color) + tag + h.color(ansiReset)
+}
+
+// color returns code unless colors are disabled.
+func (h *ConsoleHandler) color(code string) string {
+	if h.opts.NoColor {
+		return ""
+	}
+	return code
+}
+
+// formatConsoleValue renders a value, quoting strings that contain
+// whitespace.
+func formatConsoleValue(v slog.Value) string {
+	v = v.Resolve()
+	s := v.String()
+	if v.Kind() == slog.KindString && strings.ContainsAny(s, " \t") {
+		return strconv.Quote(s)
+	}
+	if s == "" {
+		return `""`
+	}
+	return fmt.Sprint(s)
+}
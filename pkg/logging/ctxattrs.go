@@ -0,0 +1,35 @@
+package logging
+
+import (
+	"context"
+	"log/slog"
+	"sync"
+)
+
+// contextExtractors holds the registered context attribute extractors.
+var contextExtractors = struct {
+	sync.RWMutex
+	fns []func(ctx context.Context) []slog.Attr
+}{}
+
+// RegisterContextExtractor registers a function that pulls attributes out
+// of a context — request IDs, user IDs, tenants — so every record logged
+// through WithContext carries them automatically. Extractors run in
+// registration order on each record.
+func RegisterContextExtractor(fn func(ctx context.Context) []slog.Attr) {
+	contextExtractors.Lock()
+	defer contextExtractors.Unlock()
+	contextExtractors.fns = append(contextExtractors.fns, fn)
+}
+
+// contextAttrs runs the registered extractors against ctx.
+func contextAttrs(ctx context.Context) []slog.Attr {
+	contextExtractors.RLock()
+	fns := contextExtractors.fns
+	contextExtractors.RUnlock()
+	var attrs []slog.Attr
+	for _, fn := range fns {
+		attrs = append(attrs, fn(ctx)...)
+	}
+	return attrs
+}
@@ -0,0 +1,23 @@
+package logging
+
+import "context"
+
+// ctxLevelKey keys a per-request level override in a context.
+type ctxLevelKey struct{}
+
+// ContextWithLevel returns a context forcing records logged with it to be
+// emitted from level upward, bypassing the configured minimum. Wire it to
+// a debug header or feature flag to turn on verbose logging for a single
+// request without raising global verbosity.
+func ContextWithLevel(ctx context.Context, level Level) context.Context {
+	return context.WithValue(ctx, ctxLevelKey{}, level)
+}
+
+// LevelFromContext reports the level override carried by ctx, if any.
+func LevelFromContext(ctx context.Context) (Level, bool) {
+	if ctx == nil {
+		return LevelInfo, false
+	}
+	level, ok := ctx.Value(ctxLevelKey{}).(Level)
+	return level, ok
+}
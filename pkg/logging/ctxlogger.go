@@ -0,0 +1,24 @@
+package logging
+
+import "context"
+
+// ctxLoggerKey keys the request-scoped logger in a context.
+type ctxLoggerKey struct{}
+
+// ContextWithLogger returns a context carrying l, so a request-scoped
+// logger (already loaded with request attributes) can flow through call
+// stacks without changing function signatures.
+func ContextWithLogger(ctx context.Context, l Logger) context.Context {
+	return context.WithValue(ctx, ctxLoggerKey{}, l)
+}
+
+// FromContext returns the logger carried by ctx, or the package default
+// when none was attached.
+func FromContext(ctx context.Context) Logger {
+	if ctx != nil {
+		if l, ok := ctx.Value(ctxLoggerKey{}).(Logger); ok {
+			return l
+		}
+	}
+	return Default()
+}
@@ -0,0 +1,222 @@
+package logging
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"fmt"
+	"log/slog"
+	"strings"
+	"sync"
+	"time"
+)
+
+const (
+	// dbFlushInterval is how often buffered rows are inserted.
+	dbFlushInterval = 2 * time.Second
+	// dbMaxBatch inserts early once this many rows are buffered.
+	dbMaxBatch = 100
+	// dbPruneInterval is how often the retention sweep runs.
+	dbPruneInterval = time.Hour
+)
+
+// DBSinkConfig controls the relational sink.
+type DBSinkConfig struct {
+	// Driver selects the placeholder dialect: "postgres" ($1, $2, ...) or
+	// "mysql" (?).
+	Driver string
+	// Table is the table name; empty means "logs". Expected schema:
+	//
+	//	CREATE TABLE logs (
+	//		id    BIGSERIAL PRIMARY KEY,      -- BIGINT AUTO_INCREMENT on MySQL
+	//		time  TIMESTAMPTZ NOT NULL,       -- DATETIME(6) on MySQL
+	//		level VARCHAR(16) NOT NULL,
+	//		msg   TEXT NOT NULL,
+	//		attrs JSONB                       -- JSON on MySQL
+	//	);
+	//	CREATE INDEX logs_time_idx ON logs (time);
+	Table string
+	// Retention prunes rows older than this age, checked hourly; 0 keeps
+	// all.
+	Retention time.Duration
+	// Level is the minimum level stored; nil means Info.
+	Level slog.Leveler
+}
+
+// DBHandler writes records into PostgreSQL or MySQL with batched inserts
+// and age-based retention pruning, so teams that centralize operational
+// data in a relational database can query logs alongside it. The caller
+// opens the connection with their driver of choice:
+//
+//	db, _ := sql.Open("pgx", dsn)
+//	h, err := logging.NewDBHandler(db, logging.DBSinkConfig{Driver: "postgres", Retention: 30 * 24 * time.Hour})
+//	logger := logging.NewSlogLoggerWithHandler(h)
+type DBHandler struct {
+	db     *sql.DB
+	cfg    DBSinkConfig
+	attrs  []slog.Attr
+	groups []string
+	batch  *dbBatch
+}
+
+// dbBatch is the insert buffer shared by a DBHandler and its
+// WithAttrs/WithGroup clones.
+type dbBatch struct {
+	mu        sync.Mutex
+	pending   []dbRow
+	timer     *time.Timer
+	lastPrune time.Time
+}
+
+// dbRow is one buffered row.
+type dbRow struct {
+	time  time.Time
+	level string
+	msg   string
+	attrs any
+}
+
+// NewDBHandler returns the handler.
+func NewDBHandler(db *sql.DB, cfg DBSinkConfig) (*DBHandler, error) {
+	if db == nil {
+		return nil, fmt.Errorf("logging: db sink requires a database")
+	}
+	switch cfg.Driver {
+	case "postgres", "mysql":
+	default:
+		return nil, fmt.Errorf("logging: db sink supports postgres or mysql, not %q", cfg.Driver)
+	}
+	if cfg.Table == "" {
+		cfg.Table = "logs"
+	}
+	return &DBHandler{db: db, cfg: cfg, batch: &dbBatch{lastPrune: time.Now()}}, nil
+}
+
+// Enabled implements slog.Handler.
+func (h *DBHandler) Enabled(_ context.Context, level slog.Level) bool {
+	min := slog.Level(LevelInfo)
+	if h.cfg.Level != nil {
+		min = h.cfg.Level.Level()
+	}
+	return level >= min
+}
+
+// Handle implements slog.Handler.
+func (h *DBHandler) Handle(_ context.Context, r slog.Record) error {
+	attrs := map[string]any{}
+	for _, a := range h.attrs {
+		attrs[a.Key] = a.Value.Resolve().Any()
+	}
+	prefix := strings.Join(h.groups, ".")
+	r.Attrs(func(a slog.Attr) bool {
+		key := a.Key
+		if prefix != "" {
+			key = prefix + "." + key
+		}
+		attrs[key] = a.Value.Resolve().Any()
+		return true
+	})
+	row := dbRow{time: r.Time.UTC(), level: r.Level.String(), msg: r.Message}
+	if len(attrs) > 0 {
+		data, err := json.Marshal(attrs)
+		if err != nil {
+			return err
+		}
+		row.attrs = string(data)
+	}
+
+	h.batch.mu.Lock()
+	defer h.batch.mu.Unlock()
+	h.batch.pending = append(h.batch.pending, row)
+	if len(h.batch.pending) >= dbMaxBatch {
+		return h.insertLocked()
+	}
+	if h.batch.timer == nil {
+		h.batch.timer = time.AfterFunc(dbFlushInterval, func() {
+			h.batch.mu.Lock()
+			defer h.batch.mu.Unlock()
+			h.insertLocked()
+		})
+	}
+	return nil
+}
+
+// WithAttrs implements slog.Handler.
+func (h *DBHandler) WithAttrs(attrs []slog.Attr) slog.Handler {
+	clone := *h
+	clone.attrs = append(append([]slog.Attr{}, h.attrs...), qualifyAll(h.groups, attrs)...)
+	return &clone
+}
+
+// WithGroup implements slog.Handler.
+func (h *DBHandler) WithGroup(name string) slog.Handler {
+	if name == "" {
+		return h
+	}
+	clone := *h
+	clone.groups = append(append([]string{}, h.groups...), name)
+	return &clone
+}
+
+// Flush inserts any buffered rows immediately.
+func (h *DBHandler) Flush(ctx context.Context) error {
+	h.batch.mu.Lock()
+	defer h.batch.mu.Unlock()
+	return h.insertLocked()
+}
+
+// insertLocked runs one multi-row insert for the pending batch and the
+// retention sweep when due. The caller must hold h.batch.mu.
+func (h *DBHandler) insertLocked() error {
+	if h.batch.timer != nil {
+		h.batch.timer.Stop()
+		h.batch.timer = nil
+	}
+	if len(h.batch.pending) == 0 {
+		return nil
+	}
+	rows := h.batch.pending
+	h.batch.pending = nil
+
+	var b strings.Builder
+	fmt.Fprintf(&b, "INSERT INTO %s (time, level, msg, attrs) VALUES ", h.cfg.Table)
+	args := make([]any, 0, len(rows)*4)
+	for i, row := range rows {
+		if i > 0 {
+			b.WriteString(", ")
+		}
+		b.WriteString(h.placeholders(i*4, 4))
+		args = append(args, row.time, row.level, row.msg, row.attrs)
+	}
+	if _, err := h.db.Exec(b.String(), args...); err != nil {
+		return err
+	}
+	if h.cfg.Retention > 0 && time.Since(h.batch.lastPrune) >= dbPruneInterval {
+		h.batch.lastPrune = time.Now()
+		prune := fmt.Sprintf("DELETE FROM %s WHERE time < %s", h.cfg.Table, h.placeholder(1))
+		if _, err := h.db.Exec(prune, time.Now().UTC().Add(-h.cfg.Retention)); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// placeholders renders one parenthesized value tuple starting after
+// offset bound parameters.
+func (h *DBHandler) placeholders(offset, n int) string {
+	parts := make([]string, n)
+	for i := 0; i < n; i++ {
+		parts[i] = h.placeholder(offset + i + 1)
+	}
+	return "(" + strings.Join(parts, ", ") + ")"
+}
+
+// placeholder renders the dialect's placeholder for parameter n (1-based).
+func (h *DBHandler) placeholder(n int) string {
+	if h.cfg.Driver == "postgres" {
+		return fmt.Sprintf("$%d", n)
+	}
+	return "?"
+}
+
+var _ Syncer = (*DBHandler)(nil)
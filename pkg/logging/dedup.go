@@ -0,0 +1,124 @@
+package logging
+
+import (
+	"context"
+	"hash/fnv"
+	"log/slog"
+	"sync"
+	"time"
+)
+
+// defaultDedupWindow is how long a run of identical records may sit
+// collapsed before the repeat count is flushed.
+const defaultDedupWindow = 5 * time.Second
+
+// dedupState is shared by a DedupHandler and its WithAttrs/WithGroup
+// clones so the run of identical records is tracked once.
+type dedupState struct {
+	mu      sync.Mutex
+	lastKey uint64
+	last    slog.Record
+	count   int
+	timer   *time.Timer
+}
+
+// DedupHandler collapses consecutive identical records, syslog-style:
+// the first occurrence passes through, repeats are held back, and when a
+// different record arrives (or the window expires) one copy is emitted
+// with a "repeated" attribute carrying the count. Useful in front of
+// tight retry loops.
+type DedupHandler struct {
+	inner  slog.Handler
+	state  *dedupState
+	window time.Duration
+}
+
+// NewDedupHandler wraps inner with duplicate suppression; a zero window
+// means five seconds.
+func NewDedupHandler(inner slog.Handler, window time.Duration) *DedupHandler {
+	if window <= 0 {
+		window = defaultDedupWindow
+	}
+	return &DedupHandler{inner: inner, state: &dedupState{}, window: window}
+}
+
+// Enabled implements slog.Handler.
+func (h *DedupHandler) Enabled(ctx context.Context, level slog.Level) bool {
+	return h.inner.Enabled(ctx, level)
+}
+
+// Handle implements slog.Handler.
+func (h *DedupHandler) Handle(ctx context.Context, r slog.Record) error {
+	key := dedupKey(r)
+	st := h.state
+	st.mu.Lock()
+	if key == st.lastKey && st.count >= 0 && !st.last.Time.IsZero() {
+		st.count++
+		if st.timer != nil {
+			st.timer.Reset(h.window)
+		}
+		st.mu.Unlock()
+		return nil
+	}
+	h.flushLocked(ctx)
+	st.lastKey = key
+	st.last = r
+	st.count = 0
+	if st.timer == nil {
+		st.timer = time.AfterFunc(h.window, func() {
+			st.mu.Lock()
+			defer st.mu.Unlock()
+			h.flushLocked(context.Background())
+			st.last = slog.Record{}
+		})
+	} else {
+		st.timer.Reset(h.window)
+	}
+	st.mu.Unlock()
+	return h.inner.Handle(ctx, r)
+}
+
+// flushLocked emits the pending repeat count, if any. Callers hold st.mu.
+func (h *DedupHandler) flushLocked(ctx context.Context) {
+	st := h.state
+	if st.count <= 0 || st.last.Time.IsZero() {
+		st.count = 0
+		return
+	}
+	summary := slog.NewRecord(time.Now(), st.last.Level, st.last.Message, st.last.PC)
+	st.last.Attrs(func(a slog.Attr) bool {
+		summary.AddAttrs(a)
+		return true
+	})
+	summary.AddAttrs(slog.Int("repeated", st.count))
+	st.count = 0
+	h.inner.Handle(ctx, summary)
+}
+
+// WithAttrs implements slog.Handler.
+func (h *DedupHandler) WithAttrs(attrs []slog.Attr) slog.Handler {
+	clone := *h
+	clone.inner = h.inner.WithAttrs(attrs)
+	return &clone
+}
+
+// WithGroup implements slog.Handler.
+func (h *DedupHandler) WithGroup(name string) slog.Handler {
+	clone := *h
+	clone.inner = h.inner.WithGroup(name)
+	return &clone
+}
+
+// dedupKey hashes the parts of a record that make it "identical" for
+// suppression: level, message and attributes, but not the timestamp.
+func dedupKey(r slog.Record) uint64 {
+	h := fnv.New64a()
+	h.Write([]byte{byte(r.Level)})
+	h.Write([]byte(r.Message))
+	r.Attrs(func(a slog.Attr) bool {
+		h.Write([]byte(a.Key))
+		h.Write([]byte(a.Value.Resolve().String()))
+		return true
+	})
+	return h.Sum64()
+}
@@ -0,0 +1,127 @@
+package logging
+
+import (
+	"context"
+	"log/slog"
+	"sync"
+	"testing"
+	"time"
+)
+
+// captureHandler records everything it handles, for asserting on handler
+// output in tests.
+type captureHandler struct {
+	mu      sync.Mutex
+	records []slog.Record
+}
+
+func (h *captureHandler) Enabled(context.Context, slog.Level) bool { return true }
+
+func (h *captureHandler) Handle(_ context.Context, r slog.Record) error {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	h.records = append(h.records, r.Clone())
+	return nil
+}
+
+func (h *captureHandler) WithAttrs([]slog.Attr) slog.Handler { return h }
+func (h *captureHandler) WithGroup(string) slog.Handler      { return h }
+
+func (h *captureHandler) snapshot() []slog.Record {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	return append([]slog.Record{}, h.records...)
+}
+
+func recordAttr(t *testing.T, r slog.Record, key string) (slog.Value, bool) {
+	t.Helper()
+	var v slog.Value
+	found := false
+	r.Attrs(func(a slog.Attr) bool {
+		if a.Key == key {
+			v = a.Value
+			found = true
+			return false
+		}
+		return true
+	})
+	return v, found
+}
+
+func dedupRecord(msg string) slog.Record {
+	return slog.NewRecord(time.Now(), slog.Level(LevelInfo), msg, 0)
+}
+
+func TestDedupSuppressesRepeats(t *testing.T) {
+	inner := &captureHandler{}
+	h := NewDedupHandler(inner, time.Minute)
+	ctx := context.Background()
+
+	for i := 0; i < 4; i++ {
+		h.Handle(ctx, dedupRecord("retrying"))
+	}
+	h.Handle(ctx, dedupRecord("different"))
+
+	records := inner.snapshot()
+	if len(records) != 3 {
+		t.Fatalf("got %d records, want 3 (first, summary, different)", len(records))
+	}
+	if records[0].Message != "retrying" || records[2].Message != "different" {
+		t.Errorf("unexpected messages %q, %q", records[0].Message, records[2].Message)
+	}
+	repeated, ok := recordAttr(t, records[1], "repeated")
+	if !ok {
+		t.Fatal("summary record has no repeated attribute")
+	}
+	if repeated.Int64() != 3 {
+		t.Errorf("repeated = %d, want 3", repeated.Int64())
+	}
+}
+
+func TestDedupWindowExpiryFlushes(t *testing.T) {
+	inner := &captureHandler{}
+	h := NewDedupHandler(inner, 50*time.Millisecond)
+	ctx := context.Background()
+
+	h.Handle(ctx, dedupRecord("retrying"))
+	h.Handle(ctx, dedupRecord("retrying"))
+
+	deadline := time.Now().Add(2 * time.Second)
+	for {
+		if len(inner.snapshot()) == 2 {
+			break
+		}
+		if time.Now().After(deadline) {
+			t.Fatalf("timer flush never arrived, got %d records", len(inner.snapshot()))
+		}
+		time.Sleep(10 * time.Millisecond)
+	}
+	repeated, ok := recordAttr(t, inner.snapshot()[1], "repeated")
+	if !ok || repeated.Int64() != 1 {
+		t.Errorf("summary repeated = %v (found %v), want 1", repeated, ok)
+	}
+
+	// The run is reset after the flush: the same message passes through
+	// again as a fresh first occurrence.
+	h.Handle(ctx, dedupRecord("retrying"))
+	if got := len(inner.snapshot()); got != 3 {
+		t.Errorf("got %d records after post-flush repeat, want 3", got)
+	}
+}
+
+func TestDedupDistinctAttrsNotCollapsed(t *testing.T) {
+	inner := &captureHandler{}
+	h := NewDedupHandler(inner, time.Minute)
+	ctx := context.Background()
+
+	r1 := dedupRecord("request")
+	r1.AddAttrs(slog.Int("attempt", 1))
+	r2 := dedupRecord("request")
+	r2.AddAttrs(slog.Int("attempt", 2))
+	h.Handle(ctx, r1)
+	h.Handle(ctx, r2)
+
+	if got := len(inner.snapshot()); got != 2 {
+		t.Errorf("got %d records, want 2 — records differing in attrs must not collapse", got)
+	}
+}
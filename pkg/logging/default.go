@@ -0,0 +1,49 @@
+package logging
+
+import (
+	"os"
+	"sync"
+)
+
+var (
+	defaultMu     sync.RWMutex
+	defaultLogger Logger
+)
+
+// SetDefault installs l as the logger behind the package-level Debug,
+// Info, Warn and Error functions.
+func SetDefault(l Logger) {
+	defaultMu.Lock()
+	defer defaultMu.Unlock()
+	defaultLogger = l
+}
+
+// Default returns the package-level logger, lazily creating a text logger
+// on stdout the first time it is needed, so small tools and init code can
+// log before anything is wired up.
+func Default() Logger {
+	defaultMu.RLock()
+	l := defaultLogger
+	defaultMu.RUnlock()
+	if l != nil {
+		return l
+	}
+	defaultMu.Lock()
+	defer defaultMu.Unlock()
+	if defaultLogger == nil {
+		defaultLogger, _ = NewSlogLogger(os.Stdout, Config{})
+	}
+	return defaultLogger
+}
+
+// Debug logs at LevelDebug via the default logger.
+func Debug(msg string, args ...any) { Default().Debug(msg, args...) }
+
+// Info logs at LevelInfo via the default logger.
+func Info(msg string, args ...any) { Default().Info(msg, args...) }
+
+// Warn logs at LevelWarn via the default logger.
+func Warn(msg string, args ...any) { Default().Warn(msg, args...) }
+
+// Error logs at LevelError via the default logger.
+func Error(msg string, args ...any) { Default().Error(msg, args...) }
@@ -0,0 +1,129 @@
+package logging
+
+import (
+	"errors"
+	"syscall"
+	"time"
+)
+
+// DegradedPolicy controls how DailyRotateWriter behaves when the
+// filesystem reports ENOSPC. Instead of erroring on every Write, the
+// writer drops records below MinLevel, keeps the rest in a bounded memory
+// ring, and periodically retries the file; once a retry succeeds the ring
+// is flushed and normal writing resumes.
+type DegradedPolicy struct {
+	// Enabled turns the policy on.
+	Enabled bool
+	// MinLevel is the lowest level retained while degraded; records below
+	// it are dropped. Defaults to LevelWarn.
+	MinLevel Level
+	// RingSize is how many retained records fit in memory; older records
+	// are evicted first. Defaults to 1024.
+	RingSize int
+	// RetryInterval is how often the file is re-probed. Defaults to 10s.
+	RetryInterval time.Duration
+}
+
+// WithDegradedPolicy configures graceful degradation on disk-full errors.
+func WithDegradedPolicy(p DegradedPolicy) RotateOption {
+	return func(w *DailyRotateWriter) {
+		if p.RingSize <= 0 {
+			p.RingSize = 1024
+		}
+		if p.RetryInterval <= 0 {
+			p.RetryInterval = 10 * time.Second
+		}
+		if p.MinLevel == 0 && p.Enabled {
+			p.MinLevel = LevelWarn
+		}
+		w.degraded = p
+	}
+}
+
+// WriteLevel writes p like Write, using level to decide what to retain
+// when the writer is in degraded mode. Plain Write assumes LevelInfo.
+func (w *DailyRotateWriter) WriteLevel(level Level, p []byte) (int, error) {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	if !w.degradedAt.IsZero() {
+		w.retryDegraded(time.Now())
+	}
+	if !w.degradedAt.IsZero() {
+		w.bufferDegraded(level, p)
+		return len(p), nil
+	}
+	now := time.Now()
+	if w.file == nil {
+		if err := w.openFile(now); err != nil {
+			if w.enterDegraded(err, level, p) {
+				return len(p), nil
+			}
+			return 0, err
+		}
+	} else if !w.clock(now).Before(w.nextRotate) {
+		// Cached-boundary comparison keeps the common path cheap; the
+		// ticker remains only as a backstop for idle writers. A failed
+		// rotation keeps writing to the previous day's file.
+		w.rotateIfNeeded(now)
+	}
+	n, err := w.file.Write(p)
+	if err != nil && w.enterDegraded(err, level, p) {
+		return len(p), nil
+	}
+	if err == nil && w.durability == DurabilityRecord {
+		err = w.file.Sync()
+	}
+	if err == nil {
+		metricBytes(n)
+	} else {
+		metricWriteError()
+	}
+	return n, err
+}
+
+// enterDegraded switches to degraded mode when err is ENOSPC and the
+// policy is enabled, buffering the record that hit the error. The caller
+// must hold w.mu.
+func (w *DailyRotateWriter) enterDegraded(err error, level Level, p []byte) bool {
+	if !w.degraded.Enabled || !errors.Is(err, syscall.ENOSPC) {
+		return false
+	}
+	w.degradedAt = time.Now()
+	w.lastRetry = w.degradedAt
+	w.bufferDegraded(level, p)
+	return true
+}
+
+// bufferDegraded retains p in the bounded ring when its level qualifies.
+// The caller must hold w.mu.
+func (w *DailyRotateWriter) bufferDegraded(level Level, p []byte) {
+	if level < w.degraded.MinLevel {
+		return
+	}
+	buf := make([]byte, len(p))
+	copy(buf, p)
+	w.ring = append(w.ring, buf)
+	if n := len(w.ring) - w.degraded.RingSize; n > 0 {
+		w.ring = w.ring[n:]
+	}
+}
+
+// retryDegraded re-probes the file once per retry interval and, on
+// success, flushes the ring and leaves degraded mode. The caller must hold
+// w.mu.
+func (w *DailyRotateWriter) retryDegraded(now time.Time) {
+	if now.Sub(w.lastRetry) < w.degraded.RetryInterval {
+		return
+	}
+	w.lastRetry = now
+	if err := w.openFile(now); err != nil {
+		return
+	}
+	for len(w.ring) > 0 {
+		if _, err := w.file.Write(w.ring[0]); err != nil {
+			return
+		}
+		w.ring = w.ring[1:]
+	}
+	w.degradedAt = time.Time{}
+}
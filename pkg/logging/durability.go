@@ -0,0 +1,49 @@
+package logging
+
+import "time"
+
+// Durability controls when DailyRotateWriter forces data to stable
+// storage, trading throughput for crash safety.
+type Durability string
+
+const (
+	// DurabilityNone never fsyncs automatically; data reaches disk at the
+	// kernel's discretion. This is the default.
+	DurabilityNone Durability = "none"
+	// DurabilityFlush fsyncs only on explicit Sync/Flush and on Close.
+	DurabilityFlush Durability = "flush"
+	// DurabilityRecord fsyncs after every record, guaranteeing that
+	// acknowledged records survive power loss. Audit-grade and slow.
+	DurabilityRecord Durability = "fsync"
+	// DurabilityInterval fsyncs on a background interval, bounding the
+	// window of records lost on power failure.
+	DurabilityInterval Durability = "fsync-interval"
+)
+
+// WithDurability sets the durability mode. interval is only used with
+// DurabilityInterval and defaults to one second.
+func WithDurability(d Durability, interval time.Duration) RotateOption {
+	if interval <= 0 {
+		interval = time.Second
+	}
+	return func(w *DailyRotateWriter) {
+		w.durability = d
+		w.syncInterval = interval
+	}
+}
+
+// autoSync periodically fsyncs the current file for DurabilityInterval.
+func (w *DailyRotateWriter) autoSync() {
+	ticker := time.NewTicker(w.syncInterval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-w.done:
+			return
+		case <-ticker.C:
+			if err := w.Sync(); err != nil {
+				w.reportError(err)
+			}
+		}
+	}
+}
@@ -0,0 +1,41 @@
+package logging
+
+import (
+	"io"
+	"log/slog"
+	"strings"
+)
+
+// ecsVersion is the Elastic Common Schema version the "ecs" format
+// declares on every record.
+const ecsVersion = "8.11"
+
+// NewECSHandler returns a JSON handler emitting ECS-compliant field names
+// (@timestamp, log.level, message, ecs.version), so output can be ingested
+// by Elasticsearch/Kibana without a transform pipeline. User attributes
+// are emitted under "labels".
+func NewECSHandler(w io.Writer, level slog.Leveler) slog.Handler {
+	opts := &slog.HandlerOptions{Level: level, ReplaceAttr: ecsReplaceAttr}
+	h := slog.NewJSONHandler(w, opts).
+		WithAttrs([]slog.Attr{slog.String("ecs.version", ecsVersion)})
+	return h.WithGroup("labels")
+}
+
+// ecsReplaceAttr maps slog's built-in keys onto their ECS equivalents.
+func ecsReplaceAttr(groups []string, a slog.Attr) slog.Attr {
+	if len(groups) > 0 {
+		return a
+	}
+	switch a.Key {
+	case slog.TimeKey:
+		a.Key = "@timestamp"
+	case slog.LevelKey:
+		a.Key = "log.level"
+		if level, ok := a.Value.Any().(slog.Level); ok {
+			a.Value = slog.StringValue(strings.ToLower(level.String()))
+		}
+	case slog.MessageKey:
+		a.Key = "message"
+	}
+	return a
+}
@@ -0,0 +1,226 @@
+package logging
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"log/slog"
+	"net/http"
+	"strings"
+	"sync"
+	"time"
+)
+
+const (
+	// elasticFlushInterval is how often buffered records are indexed.
+	elasticFlushInterval = 2 * time.Second
+	// elasticMaxBatch indexes early once this many records are buffered.
+	elasticMaxBatch = 500
+	// elasticMaxPending bounds the buffer while Elasticsearch is pushing
+	// back; beyond it the oldest records are dropped.
+	elasticMaxPending = 10000
+	// elasticMaxRetries is how many times a 429 response is retried.
+	elasticMaxRetries = 3
+)
+
+// ElasticConfig controls the Elasticsearch sink.
+type ElasticConfig struct {
+	// URL is the cluster base URL, e.g. "http://localhost:9200".
+	URL string
+	// Index is the index name prefix; records go to "<Index>-2006.01.02"
+	// (daily indices, UTC). Empty means "logs".
+	Index string
+	// Username and Password enable basic authentication.
+	Username string
+	Password string
+	// APIKey sends an "ApiKey" Authorization header instead.
+	APIKey string
+	// Level is the minimum level indexed; nil means Info.
+	Level slog.Leveler
+}
+
+// ElasticHandler indexes records through the _bulk API with daily index
+// naming, so apps can ship logs straight to Elasticsearch in environments
+// without Logstash or Filebeat. Records batch up and export
+// asynchronously; 429 responses are retried with backoff, and the retry
+// happens under the batch lock so a saturated cluster slows producers
+// down instead of growing the buffer without bound.
+type ElasticHandler struct {
+	cfg    ElasticConfig
+	client *http.Client
+	attrs  []slog.Attr
+	groups []string
+	batch  *elasticBatch
+}
+
+// elasticBatch is the export buffer shared by an ElasticHandler and its
+// WithAttrs/WithGroup clones.
+type elasticBatch struct {
+	mu      sync.Mutex
+	pending []elasticDoc
+	timer   *time.Timer
+}
+
+// elasticDoc is one buffered document with its target index.
+type elasticDoc struct {
+	index string
+	body  []byte
+}
+
+// NewElasticHandler returns the handler.
+func NewElasticHandler(cfg ElasticConfig) (*ElasticHandler, error) {
+	if cfg.URL == "" {
+		return nil, fmt.Errorf("logging: elasticsearch sink requires a URL")
+	}
+	cfg.URL = strings.TrimRight(cfg.URL, "/")
+	if cfg.Index == "" {
+		cfg.Index = "logs"
+	}
+	return &ElasticHandler{
+		cfg:    cfg,
+		client: &http.Client{Timeout: 30 * time.Second},
+		batch:  &elasticBatch{},
+	}, nil
+}
+
+// Enabled implements slog.Handler.
+func (h *ElasticHandler) Enabled(_ context.Context, level slog.Level) bool {
+	min := slog.Level(LevelInfo)
+	if h.cfg.Level != nil {
+		min = h.cfg.Level.Level()
+	}
+	return level >= min
+}
+
+// Handle implements slog.Handler.
+func (h *ElasticHandler) Handle(_ context.Context, r slog.Record) error {
+	doc := map[string]any{
+		"@timestamp": r.Time.UTC().Format(time.RFC3339Nano),
+		"level":      r.Level.String(),
+		"message":    r.Message,
+	}
+	for _, a := range h.attrs {
+		doc[a.Key] = a.Value.Resolve().Any()
+	}
+	prefix := strings.Join(h.groups, ".")
+	r.Attrs(func(a slog.Attr) bool {
+		key := a.Key
+		if prefix != "" {
+			key = prefix + "." + key
+		}
+		doc[key] = a.Value.Resolve().Any()
+		return true
+	})
+	body, err := json.Marshal(doc)
+	if err != nil {
+		return err
+	}
+	index := h.cfg.Index + "-" + r.Time.UTC().Format("2006.01.02")
+
+	h.batch.mu.Lock()
+	defer h.batch.mu.Unlock()
+	if len(h.batch.pending) >= elasticMaxPending {
+		h.batch.pending = h.batch.pending[1:]
+		metricDropped()
+	}
+	h.batch.pending = append(h.batch.pending, elasticDoc{index: index, body: body})
+	if len(h.batch.pending) >= elasticMaxBatch {
+		return h.exportLocked()
+	}
+	if h.batch.timer == nil {
+		h.batch.timer = time.AfterFunc(elasticFlushInterval, func() {
+			h.batch.mu.Lock()
+			defer h.batch.mu.Unlock()
+			h.exportLocked()
+		})
+	}
+	return nil
+}
+
+// WithAttrs implements slog.Handler.
+func (h *ElasticHandler) WithAttrs(attrs []slog.Attr) slog.Handler {
+	clone := *h
+	clone.attrs = append(append([]slog.Attr{}, h.attrs...), qualifyAll(h.groups, attrs)...)
+	return &clone
+}
+
+// WithGroup implements slog.Handler.
+func (h *ElasticHandler) WithGroup(name string) slog.Handler {
+	if name == "" {
+		return h
+	}
+	clone := *h
+	clone.groups = append(append([]string{}, h.groups...), name)
+	return &clone
+}
+
+// Flush indexes any buffered records immediately.
+func (h *ElasticHandler) Flush(ctx context.Context) error {
+	h.batch.mu.Lock()
+	defer h.batch.mu.Unlock()
+	return h.exportLocked()
+}
+
+// exportLocked POSTs the pending batch to _bulk, retrying 429 responses
+// with backoff. The caller must hold h.batch.mu.
+func (h *ElasticHandler) exportLocked() error {
+	if h.batch.timer != nil {
+		h.batch.timer.Stop()
+		h.batch.timer = nil
+	}
+	if len(h.batch.pending) == 0 {
+		return nil
+	}
+	batch := h.batch.pending
+	h.batch.pending = nil
+
+	var buf bytes.Buffer
+	for _, doc := range batch {
+		fmt.Fprintf(&buf, "{\"index\":{\"_index\":%q}}\n", doc.index)
+		buf.Write(doc.body)
+		buf.WriteByte('\n')
+	}
+	payload := buf.Bytes()
+	backoff := 500 * time.Millisecond
+	for attempt := 0; ; attempt++ {
+		status, err := h.bulk(payload)
+		if err != nil {
+			return err
+		}
+		if status != http.StatusTooManyRequests {
+			if status >= 300 {
+				return fmt.Errorf("logging: elasticsearch bulk failed: status %d", status)
+			}
+			return nil
+		}
+		if attempt >= elasticMaxRetries {
+			metricDropped()
+			return fmt.Errorf("logging: elasticsearch bulk rejected after %d retries", attempt)
+		}
+		time.Sleep(backoff)
+		backoff *= 2
+	}
+}
+
+// bulk sends one _bulk request and returns the response status.
+func (h *ElasticHandler) bulk(payload []byte) (int, error) {
+	req, err := http.NewRequest(http.MethodPost, h.cfg.URL+"/_bulk", bytes.NewReader(payload))
+	if err != nil {
+		return 0, err
+	}
+	req.Header.Set("Content-Type", "application/x-ndjson")
+	if h.cfg.APIKey != "" {
+		req.Header.Set("Authorization", "ApiKey "+h.cfg.APIKey)
+	} else if h.cfg.Username != "" {
+		req.SetBasicAuth(h.cfg.Username, h.cfg.Password)
+	}
+	resp, err := h.client.Do(req)
+	if err != nil {
+		return 0, err
+	}
+	resp.Body.Close()
+	return resp.StatusCode, nil
+}
+
+var _ Syncer = (*ElasticHandler)(nil)
@@ -0,0 +1,168 @@
+package logging
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"net"
+	"net/smtp"
+	"strings"
+	"sync"
+	"time"
+)
+
+// emailMaxDigestRecords caps how many records one digest lists in full;
+// the remainder is summarized as a count.
+const emailMaxDigestRecords = 100
+
+// EmailAlertConfig controls the SMTP digest hook.
+type EmailAlertConfig struct {
+	// Addr is the SMTP server as "host:port", e.g. "mail.example.com:587".
+	Addr string
+	// Username and Password enable PLAIN authentication; leave empty for
+	// unauthenticated relays.
+	Username string
+	Password string
+	// From is the sender address; To lists the recipients.
+	From string
+	To   []string
+	// Subject prefixes the digest subject line; empty means "[logging]".
+	Subject string
+	// MinLevel is the lowest level collected; nil means LevelError, so a
+	// MinLevel of LevelInfo is expressible.
+	MinLevel slog.Leveler
+	// Window is how long records aggregate before a digest is sent, so a
+	// burst becomes one mail instead of hundreds; 0 means 5 minutes. At
+	// most one mail goes out per window.
+	Window time.Duration
+}
+
+// EmailAlertHook aggregates high-severity records and mails them as a
+// periodic digest over SMTP, for small deployments without chat or
+// alerting infrastructure. The first matching record arms the window
+// timer; everything arriving before it fires joins the same digest.
+// Records always pass through to the normal output.
+type EmailAlertHook struct {
+	cfg EmailAlertConfig
+
+	mu      sync.Mutex
+	pending []string
+	extra   int
+	timer   *time.Timer
+	wg      sync.WaitGroup
+}
+
+// NewEmailAlertHook returns the hook.
+func NewEmailAlertHook(cfg EmailAlertConfig) (*EmailAlertHook, error) {
+	if cfg.Addr == "" || cfg.From == "" || len(cfg.To) == 0 {
+		return nil, fmt.Errorf("logging: email alert requires Addr, From and To")
+	}
+	if cfg.Subject == "" {
+		cfg.Subject = "[logging]"
+	}
+	if cfg.MinLevel == nil {
+		cfg.MinLevel = LevelError
+	}
+	if cfg.Window <= 0 {
+		cfg.Window = 5 * time.Minute
+	}
+	return &EmailAlertHook{cfg: cfg}, nil
+}
+
+// Hook returns the collecting hook for AddHook or Config.Hooks.
+func (h *EmailAlertHook) Hook() Hook {
+	return func(ctx context.Context, r Record) (Record, bool) {
+		if r.Level >= h.cfg.MinLevel.Level() {
+			h.collect(r)
+		}
+		return r, true
+	}
+}
+
+// collect queues one record and arms the digest timer.
+func (h *EmailAlertHook) collect(r Record) {
+	var b strings.Builder
+	fmt.Fprintf(&b, "%s [%s] %s", r.Time.Format(time.RFC3339), r.Level, r.Message)
+	for _, a := range r.Attrs {
+		fmt.Fprintf(&b, " %s=%s", a.Key, a.Value.Resolve())
+	}
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	if len(h.pending) >= emailMaxDigestRecords {
+		h.extra++
+	} else {
+		h.pending = append(h.pending, b.String())
+	}
+	if h.timer == nil {
+		h.timer = time.AfterFunc(h.cfg.Window, h.flushDigest)
+	}
+}
+
+// flushDigest sends the accumulated digest and re-arms for the next
+// window's records.
+func (h *EmailAlertHook) flushDigest() {
+	h.mu.Lock()
+	lines := h.pending
+	extra := h.extra
+	h.pending = nil
+	h.extra = 0
+	h.timer = nil
+	h.mu.Unlock()
+	if len(lines) == 0 {
+		return
+	}
+	h.wg.Add(1)
+	go h.send(lines, extra)
+}
+
+// send mails one digest; failures are dropped silently.
+func (h *EmailAlertHook) send(lines []string, extra int) {
+	defer h.wg.Done()
+	subject := fmt.Sprintf("%s %d error record(s)", h.cfg.Subject, len(lines)+extra)
+	var body strings.Builder
+	fmt.Fprintf(&body, "From: %s\r\n", h.cfg.From)
+	fmt.Fprintf(&body, "To: %s\r\n", strings.Join(h.cfg.To, ", "))
+	fmt.Fprintf(&body, "Subject: %s\r\n", subject)
+	body.WriteString("MIME-Version: 1.0\r\nContent-Type: text/plain; charset=utf-8\r\n\r\n")
+	for _, line := range lines {
+		body.WriteString(line)
+		body.WriteString("\r\n")
+	}
+	if extra > 0 {
+		fmt.Fprintf(&body, "... and %d more\r\n", extra)
+	}
+	var auth smtp.Auth
+	if h.cfg.Username != "" {
+		host, _, err := net.SplitHostPort(h.cfg.Addr)
+		if err != nil {
+			host = h.cfg.Addr
+		}
+		auth = smtp.PlainAuth("", h.cfg.Username, h.cfg.Password, host)
+	}
+	smtp.SendMail(h.cfg.Addr, auth, h.cfg.From, h.cfg.To, []byte(body.String()))
+}
+
+// Flush sends any partial digest immediately and waits for in-flight
+// mail, bounded by ctx.
+func (h *EmailAlertHook) Flush(ctx context.Context) error {
+	h.mu.Lock()
+	if h.timer != nil {
+		h.timer.Stop()
+		h.timer = nil
+	}
+	h.mu.Unlock()
+	h.flushDigest()
+	done := make(chan struct{})
+	go func() {
+		h.wg.Wait()
+		close(done)
+	}()
+	select {
+	case <-done:
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
+
+var _ Syncer = (*EmailAlertHook)(nil)
@@ -0,0 +1,106 @@
+package logging
+
+import (
+	"bytes"
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/rand"
+	"errors"
+	"fmt"
+	"os"
+	"strings"
+)
+
+// encryptedSuffix is appended to rotated files after encryption.
+const encryptedSuffix = ".enc"
+
+// encryptedMagic identifies files produced by encryptFile.
+var encryptedMagic = []byte("YUANGOENC1")
+
+// WithEncryption encrypts each file as it is rotated out using AES-GCM
+// with the given key (16, 24 or 32 bytes), writing "<name>.enc" and
+// removing the plaintext. The active file stays plaintext so it can still
+// be tailed. Use DecryptLogFile to read archived files back.
+func WithEncryption(key []byte) RotateOption {
+	return func(w *DailyRotateWriter) {
+		k := make([]byte, len(key))
+		copy(k, key)
+		w.OnRotate(func(oldPath, newPath string) {
+			if oldPath == "" || strings.HasSuffix(oldPath, encryptedSuffix) {
+				return
+			}
+			encryptFile(oldPath, k)
+		})
+	}
+}
+
+// WithEncryptionKeyFile is WithEncryption reading the key from a file,
+// trimming trailing whitespace. A missing or unreadable key file fails
+// NewDailyRotateWriter — silently disabling encryption would archive the
+// data this option exists to protect in plaintext.
+func WithEncryptionKeyFile(path string) RotateOption {
+	key, err := os.ReadFile(path)
+	if err != nil {
+		return func(w *DailyRotateWriter) {
+			w.optErr = fmt.Errorf("logging: encryption key file: %w", err)
+		}
+	}
+	return WithEncryption(bytes.TrimRight(key, "\r\n"))
+}
+
+// encryptFile seals name into name+".enc" and deletes the plaintext. The
+// plaintext is only removed once the encrypted file is fully written.
+func encryptFile(name string, key []byte) error {
+	plaintext, err := os.ReadFile(name)
+	if err != nil {
+		return err
+	}
+	aead, err := newAEAD(key)
+	if err != nil {
+		return err
+	}
+	nonce := make([]byte, aead.NonceSize())
+	if _, err := rand.Read(nonce); err != nil {
+		return err
+	}
+	out := append([]byte{}, encryptedMagic...)
+	out = append(out, nonce...)
+	out = aead.Seal(out, nonce, plaintext, nil)
+	if err := os.WriteFile(name+encryptedSuffix, out, 0o600); err != nil {
+		return err
+	}
+	return os.Remove(name)
+}
+
+// DecryptLogFile decrypts a file produced by WithEncryption into dst.
+func DecryptLogFile(src, dst string, key []byte) error {
+	data, err := os.ReadFile(src)
+	if err != nil {
+		return err
+	}
+	if !bytes.HasPrefix(data, encryptedMagic) {
+		return errors.New("logging: not an encrypted log file")
+	}
+	data = data[len(encryptedMagic):]
+	aead, err := newAEAD(key)
+	if err != nil {
+		return err
+	}
+	if len(data) < aead.NonceSize() {
+		return errors.New("logging: encrypted log file truncated")
+	}
+	nonce, ciphertext := data[:aead.NonceSize()], data[aead.NonceSize():]
+	plaintext, err := aead.Open(nil, nonce, ciphertext, nil)
+	if err != nil {
+		return fmt.Errorf("logging: decrypt failed: %w", err)
+	}
+	return os.WriteFile(dst, plaintext, 0o600)
+}
+
+func newAEAD(key []byte) (cipher.AEAD, error) {
+	block, err := aes.NewCipher(key)
+	if err != nil {
+		return nil, fmt.Errorf("logging: bad encryption key: %w", err)
+	}
+	return cipher.NewGCM(block)
+}
@@ -0,0 +1,96 @@
+package logging
+
+import (
+	"errors"
+	"log/slog"
+	"runtime"
+	"strconv"
+	"strings"
+)
+
+// StackTracer is implemented by errors that carry the raw program
+// counters of their construction site. Note that github.com/pkg/errors
+// does NOT satisfy this interface — its StackTrace method returns
+// errors.StackTrace ([]errors.Frame), not []uintptr — so errors from
+// that package need a small adapter exposing the counters under this
+// signature.
+type StackTracer interface {
+	StackTrace() []uintptr
+}
+
+// ErrorKey is the canonical attribute key errors are logged under.
+const ErrorKey = "error"
+
+// Err attaches err under the canonical "error" key, serialized through
+// ErrorValue, replacing the ad-hoc "err"/"error"/"e" keys used before.
+func Err(err error) slog.Attr {
+	return slog.Attr{Key: ErrorKey, Value: ErrorValue(err)}
+}
+
+// ErrorValue serializes err as a nested object: its message, the messages
+// of its unwrapped cause chain, and — when err implements StackTracer —
+// its stack. It renders as a JSON object under JSON-style formats instead
+// of the flat string slog produces by default.
+func ErrorValue(err error) slog.Value {
+	if err == nil {
+		return slog.AnyValue(nil)
+	}
+	attrs := []slog.Attr{slog.String("message", err.Error())}
+	var causes []string
+	for cause := errors.Unwrap(err); cause != nil; cause = errors.Unwrap(cause) {
+		causes = append(causes, cause.Error())
+	}
+	if len(causes) > 0 {
+		attrs = append(attrs, slog.Any("causes", causes))
+	}
+	var st StackTracer
+	if errors.As(err, &st) {
+		if stack := formatErrorStack(st.StackTrace()); stack != "" {
+			attrs = append(attrs, slog.String("stack", stack))
+		}
+	}
+	return slog.GroupValue(attrs...)
+}
+
+// errorReplaceAttr builds a ReplaceAttr hook that rewrites error-valued
+// attributes through ErrorValue. It returns nil unless cfg.RichErrors is
+// set.
+func errorReplaceAttr(cfg Config) func(groups []string, a slog.Attr) slog.Attr {
+	if !cfg.RichErrors {
+		return nil
+	}
+	return func(groups []string, a slog.Attr) slog.Attr {
+		if a.Value.Kind() != slog.KindAny {
+			return a
+		}
+		if err, ok := a.Value.Any().(error); ok {
+			a.Value = ErrorValue(err)
+		}
+		return a
+	}
+}
+
+// formatErrorStack renders an error's program counters in the same
+// file:line form captureStack uses.
+func formatErrorStack(pcs []uintptr) string {
+	if len(pcs) == 0 {
+		return ""
+	}
+	var b strings.Builder
+	frames := runtime.CallersFrames(pcs)
+	for {
+		frame, more := frames.Next()
+		if frame.Function != "" {
+			b.WriteString(frame.Function)
+			b.WriteString("\n\t")
+			b.WriteString(frame.File)
+			b.WriteString(":")
+			b.WriteString(strconv.Itoa(frame.Line))
+			b.WriteString("\n")
+		}
+		if !more {
+			break
+		}
+	}
+	return strings.TrimRight(b.String(), "\n")
+}
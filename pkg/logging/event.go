@@ -0,0 +1,51 @@
+package logging
+
+import (
+	"context"
+	"log/slog"
+)
+
+// Event is a strongly-typed log event. Teams define concrete types —
+// UserRegistered, PaymentFailed — whose Attrs methods fix the field names
+// once, so the schema cannot drift between call sites.
+type Event interface {
+	// Name is the record message, e.g. "user_registered".
+	Name() string
+	// Attrs are the event's attributes.
+	Attrs() []slog.Attr
+}
+
+// LeveledEvent lets an event choose its own level; plain Events log at
+// LevelInfo.
+type LeveledEvent interface {
+	Event
+	Level() Level
+}
+
+// LogEvent emits e through l with ctx attached.
+func LogEvent(ctx context.Context, l Logger, e Event) {
+	level := Level(LevelInfo)
+	if le, ok := e.(LeveledEvent); ok {
+		level = le.Level()
+	}
+	args := make([]any, 0, len(e.Attrs()))
+	for _, a := range e.Attrs() {
+		args = append(args, a)
+	}
+	target := l.WithContext(ctx)
+	switch {
+	case level >= LevelError:
+		target.Error(e.Name(), args...)
+	case level >= LevelWarn:
+		target.Warn(e.Name(), args...)
+	case level >= LevelInfo:
+		target.Info(e.Name(), args...)
+	default:
+		target.Debug(e.Name(), args...)
+	}
+}
+
+// Event emits a typed event through this logger.
+func (l *SlogLogger) Event(ctx context.Context, e Event) {
+	LogEvent(ctx, l, e)
+}
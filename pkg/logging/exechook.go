@@ -0,0 +1,39 @@
+package logging
+
+import (
+	"context"
+	"fmt"
+	"os/exec"
+	"strings"
+	"time"
+)
+
+// defaultExecTimeout bounds a post-rotation command when no timeout is
+// given.
+const defaultExecTimeout = time.Minute
+
+// WithPostRotateCommand runs an external command after each rotation, e.g.
+// a legacy shipping script. The occurrences of "{path}" in command are
+// replaced with the path of the file rotated out. The command runs
+// asynchronously under "sh -c" with the given timeout (one minute when
+// zero); failures are reported through the writer's error handler.
+func WithPostRotateCommand(command string, timeout time.Duration) RotateOption {
+	if timeout <= 0 {
+		timeout = defaultExecTimeout
+	}
+	return func(w *DailyRotateWriter) {
+		w.OnRotate(func(oldPath, newPath string) {
+			if oldPath == "" {
+				return
+			}
+			ctx, cancel := context.WithTimeout(context.Background(), timeout)
+			defer cancel()
+			cmdline := strings.ReplaceAll(command, "{path}", oldPath)
+			out, err := exec.CommandContext(ctx, "sh", "-c", cmdline).CombinedOutput()
+			if err != nil {
+				w.reportError(fmt.Errorf("logging: post-rotate command %q failed: %w (output: %s)",
+					cmdline, err, strings.TrimSpace(string(out))))
+			}
+		})
+	}
+}
@@ -0,0 +1,81 @@
+package logging
+
+import (
+	"context"
+	"io"
+	"os"
+	"sync"
+	"time"
+)
+
+// failoverRetryInterval is how long FailoverWriter stays on the fallback
+// before probing the primary again.
+const failoverRetryInterval = 5 * time.Second
+
+// FailoverWriter wraps a primary output and transparently redirects writes
+// to a secondary writer (stderr by default) while the primary is failing,
+// so records are not lost silently on disk or network errors. Once the
+// primary succeeds again, writes resume going to it.
+type FailoverWriter struct {
+	primary  io.Writer
+	fallback io.Writer
+
+	mu       sync.Mutex
+	failedAt time.Time
+}
+
+// NewFailoverWriter returns a FailoverWriter over primary. A nil fallback
+// defaults to os.Stderr.
+func NewFailoverWriter(primary, fallback io.Writer) *FailoverWriter {
+	if fallback == nil {
+		fallback = os.Stderr
+	}
+	return &FailoverWriter{primary: primary, fallback: fallback}
+}
+
+// Write writes p to the primary, falling back to the secondary writer when
+// the primary errors. While failed over, the primary is re-probed at most
+// once per retry interval.
+func (f *FailoverWriter) Write(p []byte) (int, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	if !f.failedAt.IsZero() && time.Since(f.failedAt) < failoverRetryInterval {
+		return f.fallback.Write(p)
+	}
+	n, err := f.primary.Write(p)
+	if err == nil {
+		f.failedAt = time.Time{}
+		return n, nil
+	}
+	f.failedAt = time.Now()
+	return f.fallback.Write(p)
+}
+
+// Flush flushes whichever writers support it.
+func (f *FailoverWriter) Flush(ctx context.Context) error {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	for _, w := range []io.Writer{f.primary, f.fallback} {
+		switch out := w.(type) {
+		case Syncer:
+			if err := out.Flush(ctx); err != nil {
+				return err
+			}
+		case interface{ Sync() error }:
+			if err := out.Sync(); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// Close closes the primary when it is an io.Closer.
+func (f *FailoverWriter) Close() error {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	if c, ok := f.primary.(io.Closer); ok {
+		return c.Close()
+	}
+	return nil
+}
@@ -0,0 +1,155 @@
+package logging
+
+import (
+	"context"
+	"errors"
+	"io"
+	"log/slog"
+	"os"
+)
+
+// FanoutHandler dispatches each record to several handlers, each with its
+// own encoding and minimum level — the handler-level counterpart of
+// MultiWriter. A record goes to every child whose Enabled accepts it, and
+// the joined errors of any children that failed are reported.
+type FanoutHandler struct {
+	handlers []slog.Handler
+}
+
+// NewFanoutHandler returns a FanoutHandler over the given handlers.
+func NewFanoutHandler(handlers ...slog.Handler) *FanoutHandler {
+	return &FanoutHandler{handlers: handlers}
+}
+
+// Enabled implements slog.Handler; a record is enabled when any child
+// accepts it.
+func (h *FanoutHandler) Enabled(ctx context.Context, level slog.Level) bool {
+	for _, child := range h.handlers {
+		if h.childEnabled(ctx, child, level) {
+			return true
+		}
+	}
+	return false
+}
+
+// childEnabled gates one child, letting a per-request level override from
+// ContextWithLevel bypass the child's configured minimum the same way
+// SlogLogger.log bypasses the top-level gate.
+func (h *FanoutHandler) childEnabled(ctx context.Context, child slog.Handler, level slog.Level) bool {
+	if override, ok := LevelFromContext(ctx); ok {
+		return level >= override
+	}
+	return child.Enabled(ctx, level)
+}
+
+// Handle implements slog.Handler.
+func (h *FanoutHandler) Handle(ctx context.Context, r slog.Record) error {
+	var errs []error
+	for _, child := range h.handlers {
+		if !h.childEnabled(ctx, child, r.Level) {
+			continue
+		}
+		if err := child.Handle(ctx, r.Clone()); err != nil {
+			errs = append(errs, err)
+		}
+	}
+	return errors.Join(errs...)
+}
+
+// WithAttrs implements slog.Handler.
+func (h *FanoutHandler) WithAttrs(attrs []slog.Attr) slog.Handler {
+	children := make([]slog.Handler, len(h.handlers))
+	for i, child := range h.handlers {
+		children[i] = child.WithAttrs(attrs)
+	}
+	return &FanoutHandler{handlers: children}
+}
+
+// WithGroup implements slog.Handler.
+func (h *FanoutHandler) WithGroup(name string) slog.Handler {
+	if name == "" {
+		return h
+	}
+	children := make([]slog.Handler, len(h.handlers))
+	for i, child := range h.handlers {
+		children[i] = child.WithGroup(name)
+	}
+	return &FanoutHandler{handlers: children}
+}
+
+// newFanoutFromConfig builds a logger over cfg.Outputs, one encoder per
+// entry. Entries inherit the base configuration and override destination,
+// format and minimum level; entries without their own level follow the
+// logger's SetLevel.
+func newFanoutFromConfig(cfg Config) (*SlogLogger, error) {
+	base, err := ParseLevel(cfg.Level)
+	if err != nil {
+		return nil, err
+	}
+	levelVar := new(slog.LevelVar)
+	levelVar.Set(base)
+	handlers := make([]slog.Handler, 0, len(cfg.Outputs))
+	writers := make([]io.Writer, 0, len(cfg.Outputs))
+	for _, out := range cfg.Outputs {
+		sub := cfg
+		sub.Outputs = nil
+		if out.Output != "" {
+			sub.Output = out.Output
+		}
+		if out.Format != "" {
+			sub.Format = out.Format
+		}
+		if out.Level != "" {
+			sub.Level = out.Level
+		}
+		w, err := openOutput(sub)
+		if err != nil {
+			return nil, err
+		}
+		replace, err := resolveReplaceAttr(sub)
+		if err != nil {
+			return nil, err
+		}
+		var level slog.Leveler = levelVar
+		if out.Level != "" {
+			fixed, err := ParseLevel(out.Level)
+			if err != nil {
+				return nil, err
+			}
+			level = fixed
+		}
+		h, err := newEncoder(w, sub, level, replace)
+		if err != nil {
+			return nil, err
+		}
+		if out.MaxLevel != "" {
+			max, err := ParseLevel(out.MaxLevel)
+			if err != nil {
+				return nil, err
+			}
+			h = maxLevelHandler{inner: h, max: max}
+		}
+		if len(out.Match) > 0 {
+			h, err = newMatchHandler(h, out.Match)
+			if err != nil {
+				return nil, err
+			}
+		}
+		handlers = append(handlers, h)
+		writers = append(writers, w)
+	}
+	l, err := assembleLogger(NewFanoutHandler(handlers...), NewMultiWriter(writers...), cfg, levelVar)
+	if err != nil {
+		return nil, err
+	}
+	// Close should release only writers opened here; entries targeting
+	// stdout/stderr leave nothing to own (MultiWriter.Close also skips
+	// the process streams).
+	for _, w := range writers {
+		if w != os.Stdout && w != os.Stderr {
+			l.ownsOut = true
+			break
+		}
+	}
+	return l, nil
+}
@@ -0,0 +1,126 @@
+package logging
+
+import (
+	"context"
+	"regexp"
+)
+
+// FilterRule describes one declarative record predicate. Empty fields
+// match everything, so a rule only constrains the dimensions it sets.
+type FilterRule struct {
+	// MinLevel and MaxLevel bound the level range, e.g. "warn"/"error".
+	MinLevel string `json:"min_level"`
+	MaxLevel string `json:"max_level"`
+
+	// MessagePattern is a regular expression matched against the message.
+	MessagePattern string `json:"message_pattern"`
+
+	// Attrs requires attribute equality on rendered values, e.g.
+	// {"component": "noisy"} or {"path": "/healthz"}.
+	Attrs map[string]string `json:"attrs"`
+}
+
+// compiledFilter is a FilterRule with its pieces resolved.
+type compiledFilter struct {
+	min, max *Level
+	pattern  *regexp.Regexp
+	attrs    map[string]string
+}
+
+func compileFilterRule(rule FilterRule) (compiledFilter, error) {
+	var f compiledFilter
+	if rule.MinLevel != "" {
+		level, err := ParseLevel(rule.MinLevel)
+		if err != nil {
+			return f, err
+		}
+		f.min = &level
+	}
+	if rule.MaxLevel != "" {
+		level, err := ParseLevel(rule.MaxLevel)
+		if err != nil {
+			return f, err
+		}
+		f.max = &level
+	}
+	if rule.MessagePattern != "" {
+		re, err := regexp.Compile(rule.MessagePattern)
+		if err != nil {
+			return f, err
+		}
+		f.pattern = re
+	}
+	f.attrs = rule.Attrs
+	return f, nil
+}
+
+// matches reports whether r satisfies every constraint the rule sets.
+func (f compiledFilter) matches(r Record) bool {
+	if f.min != nil && r.Level < *f.min {
+		return false
+	}
+	if f.max != nil && r.Level > *f.max {
+		return false
+	}
+	if f.pattern != nil && !f.pattern.MatchString(r.Message) {
+		return false
+	}
+	for key, want := range f.attrs {
+		found := false
+		for _, a := range r.Attrs {
+			if a.Key == key && a.Value.Resolve().String() == want {
+				found = true
+				break
+			}
+		}
+		if !found {
+			return false
+		}
+	}
+	return true
+}
+
+// filterHook compiles cfg's include/exclude rules into a hook. A record
+// passes when it matches at least one include rule (or none are set) and
+// no exclude rule. It returns nil when no rules are configured.
+func filterHook(cfg Config) (Hook, error) {
+	if len(cfg.Include) == 0 && len(cfg.Exclude) == 0 {
+		return nil, nil
+	}
+	include := make([]compiledFilter, 0, len(cfg.Include))
+	for _, rule := range cfg.Include {
+		f, err := compileFilterRule(rule)
+		if err != nil {
+			return nil, err
+		}
+		include = append(include, f)
+	}
+	exclude := make([]compiledFilter, 0, len(cfg.Exclude))
+	for _, rule := range cfg.Exclude {
+		f, err := compileFilterRule(rule)
+		if err != nil {
+			return nil, err
+		}
+		exclude = append(exclude, f)
+	}
+	return func(ctx context.Context, r Record) (Record, bool) {
+		if len(include) > 0 {
+			matched := false
+			for _, f := range include {
+				if f.matches(r) {
+					matched = true
+					break
+				}
+			}
+			if !matched {
+				return r, false
+			}
+		}
+		for _, f := range exclude {
+			if f.matches(r) {
+				return r, false
+			}
+		}
+		return r, true
+	}, nil
+}
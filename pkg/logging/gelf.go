@@ -0,0 +1,142 @@
+package logging
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"log/slog"
+	"os"
+	"strings"
+	"sync"
+)
+
+// GELFHandler encodes records as GELF 1.1 messages (one JSON object per
+// line) so output can be consumed by Graylog directly. Record attributes
+// become additional fields prefixed with "_"; levels map onto syslog
+// severities.
+type GELFHandler struct {
+	w      io.Writer
+	mu     *sync.Mutex
+	level  slog.Leveler
+	host   string
+	attrs  []slog.Attr
+	groups []string
+}
+
+// NewGELFHandler returns a GELFHandler writing to w at the given minimum
+// level (LevelInfo when nil).
+func NewGELFHandler(w io.Writer, level slog.Leveler) *GELFHandler {
+	host, _ := os.Hostname()
+	if host == "" {
+		host = "localhost"
+	}
+	return &GELFHandler{w: w, mu: &sync.Mutex{}, level: level, host: host}
+}
+
+// Enabled implements slog.Handler.
+func (h *GELFHandler) Enabled(_ context.Context, level slog.Level) bool {
+	min := slog.Level(LevelInfo)
+	if h.level != nil {
+		min = h.level.Level()
+	}
+	return level >= min
+}
+
+// Handle implements slog.Handler.
+func (h *GELFHandler) Handle(_ context.Context, r slog.Record) error {
+	msg := map[string]any{
+		"version":       "1.1",
+		"host":          h.host,
+		"short_message": shortMessage(r.Message),
+		"level":         gelfSeverity(r.Level),
+	}
+	if r.Message != msg["short_message"] {
+		msg["full_message"] = r.Message
+	}
+	if !r.Time.IsZero() {
+		msg["timestamp"] = float64(r.Time.UnixNano()) / 1e9
+	}
+	for _, a := range h.attrs {
+		addGELFField(msg, "", a)
+	}
+	prefix := strings.Join(h.groups, "_")
+	r.Attrs(func(a slog.Attr) bool {
+		addGELFField(msg, prefix, a)
+		return true
+	})
+	line, err := json.Marshal(msg)
+	if err != nil {
+		return err
+	}
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	_, err = h.w.Write(append(line, '\n'))
+	return err
+}
+
+// WithAttrs implements slog.Handler.
+func (h *GELFHandler) WithAttrs(attrs []slog.Attr) slog.Handler {
+	clone := *h
+	prefixed := attrs
+	if prefix := strings.Join(h.groups, "_"); prefix != "" {
+		prefixed = make([]slog.Attr, len(attrs))
+		for i, a := range attrs {
+			a.Key = prefix + "_" + a.Key
+			prefixed[i] = a
+		}
+	}
+	clone.attrs = append(append([]slog.Attr{}, h.attrs...), prefixed...)
+	return &clone
+}
+
+// WithGroup implements slog.Handler.
+func (h *GELFHandler) WithGroup(name string) slog.Handler {
+	if name == "" {
+		return h
+	}
+	clone := *h
+	clone.groups = append(append([]string{}, h.groups...), name)
+	return &clone
+}
+
+// addGELFField stores a as an additional "_"-prefixed field, flattening
+// groups with "_" separators. "id" is reserved by the spec and renamed.
+func addGELFField(msg map[string]any, prefix string, a slog.Attr) {
+	key := a.Key
+	if prefix != "" {
+		key = prefix + "_" + key
+	}
+	v := a.Value.Resolve()
+	if v.Kind() == slog.KindGroup {
+		for _, g := range v.Group() {
+			addGELFField(msg, key, g)
+		}
+		return
+	}
+	if key == "id" {
+		key = "id_"
+	}
+	msg["_"+key] = v.Any()
+}
+
+// shortMessage returns the first line of msg.
+func shortMessage(msg string) string {
+	if i := strings.IndexByte(msg, '\n'); i >= 0 {
+		return msg[:i]
+	}
+	return msg
+}
+
+// gelfSeverity maps slog levels onto syslog severities.
+func gelfSeverity(level slog.Level) int {
+	switch {
+	case level >= LevelError:
+		return 3 // error
+	case level >= LevelWarn:
+		return 4 // warning
+	case level >= LevelInfo:
+		return 6 // informational
+	default:
+		return 7 // debug
+	}
+}
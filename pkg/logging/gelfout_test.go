@@ -0,0 +1,147 @@
+package logging
+
+import (
+	"bytes"
+	"io"
+	"net"
+	"testing"
+	"time"
+)
+
+// gelfUDPListener collects datagrams sent to a loopback UDP socket.
+func gelfUDPListener(t *testing.T) (net.PacketConn, string) {
+	t.Helper()
+	pc, err := net.ListenPacket("udp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatalf("ListenPacket: %v", err)
+	}
+	t.Cleanup(func() { pc.Close() })
+	return pc, pc.LocalAddr().String()
+}
+
+func readDatagrams(t *testing.T, pc net.PacketConn, n int) [][]byte {
+	t.Helper()
+	var got [][]byte
+	buf := make([]byte, 64<<10)
+	for i := 0; i < n; i++ {
+		pc.SetReadDeadline(time.Now().Add(2 * time.Second))
+		size, _, err := pc.ReadFrom(buf)
+		if err != nil {
+			t.Fatalf("datagram %d: %v", i, err)
+		}
+		got = append(got, append([]byte{}, buf[:size]...))
+	}
+	return got
+}
+
+func TestGELFUDPSmallMessageSingleDatagram(t *testing.T) {
+	pc, addr := gelfUDPListener(t)
+	w := &gelfUDPWriter{conn: newNetWriter("udp", addr)}
+	defer w.Close()
+
+	msg := []byte(`{"short_message":"hi"}` + "\n")
+	if _, err := w.Write(msg); err != nil {
+		t.Fatalf("Write: %v", err)
+	}
+	got := readDatagrams(t, pc, 1)[0]
+	if !bytes.Equal(got, msg[:len(msg)-1]) {
+		t.Errorf("datagram = %q, want message without trailing newline", got)
+	}
+}
+
+func TestGELFUDPChunking(t *testing.T) {
+	pc, addr := gelfUDPListener(t)
+	w := &gelfUDPWriter{conn: newNetWriter("udp", addr)}
+	defer w.Close()
+
+	msg := bytes.Repeat([]byte("x"), 20000)
+	if _, err := w.Write(append(append([]byte{}, msg...), '\n')); err != nil {
+		t.Fatalf("Write: %v", err)
+	}
+	payload := gelfMaxDatagram - gelfChunkHeaderLen
+	wantChunks := (len(msg) + payload - 1) / payload
+	chunks := readDatagrams(t, pc, wantChunks)
+
+	var id []byte
+	var reassembled []byte
+	for seq, c := range chunks {
+		if len(c) < gelfChunkHeaderLen {
+			t.Fatalf("chunk %d is only %d bytes", seq, len(c))
+		}
+		if c[0] != 0x1e || c[1] != 0x0f {
+			t.Fatalf("chunk %d magic = %x %x, want 1e 0f", seq, c[0], c[1])
+		}
+		if id == nil {
+			id = c[2:10]
+		} else if !bytes.Equal(id, c[2:10]) {
+			t.Errorf("chunk %d carries a different message id", seq)
+		}
+		if int(c[10]) != seq || int(c[11]) != wantChunks {
+			t.Errorf("chunk %d header seq/count = %d/%d, want %d/%d", seq, c[10], c[11], seq, wantChunks)
+		}
+		if seq < wantChunks-1 && len(c) != gelfMaxDatagram {
+			t.Errorf("chunk %d is %d bytes, want a full %d-byte datagram", seq, len(c), gelfMaxDatagram)
+		}
+		reassembled = append(reassembled, c[gelfChunkHeaderLen:]...)
+	}
+	if !bytes.Equal(reassembled, msg) {
+		t.Error("reassembled chunks do not equal the original message")
+	}
+}
+
+func TestGELFUDPTooManyChunks(t *testing.T) {
+	_, addr := gelfUDPListener(t)
+	w := &gelfUDPWriter{conn: newNetWriter("udp", addr)}
+	defer w.Close()
+
+	payload := gelfMaxDatagram - gelfChunkHeaderLen
+	msg := bytes.Repeat([]byte("x"), payload*gelfMaxChunks+1)
+	if _, err := w.Write(msg); err == nil {
+		t.Fatal("Write accepted a message over the 128-chunk limit")
+	}
+}
+
+func TestGELFStreamNullFraming(t *testing.T) {
+	ln, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatalf("Listen: %v", err)
+	}
+	defer ln.Close()
+	received := make(chan []byte, 1)
+	go func() {
+		conn, err := ln.Accept()
+		if err != nil {
+			return
+		}
+		data, _ := io.ReadAll(conn)
+		received <- data
+	}()
+
+	w := &gelfStreamWriter{conn: newNetWriter("tcp", ln.Addr().String())}
+	if _, err := w.Write([]byte(`{"short_message":"a"}` + "\n")); err != nil {
+		t.Fatalf("Write: %v", err)
+	}
+	if _, err := w.Write([]byte(`{"short_message":"b"}`)); err != nil {
+		t.Fatalf("Write: %v", err)
+	}
+	w.Close()
+
+	select {
+	case data := <-received:
+		want := `{"short_message":"a"}` + "\x00" + `{"short_message":"b"}` + "\x00"
+		if string(data) != want {
+			t.Errorf("stream = %q, want %q", data, want)
+		}
+	case <-time.After(2 * time.Second):
+		t.Fatal("listener never received the framed messages")
+	}
+}
+
+func TestOpenGELFOutputRejectsBadURL(t *testing.T) {
+	if _, err := openGELFOutput("gelf://"); err == nil {
+		t.Error("openGELFOutput accepted a URL without a host")
+	}
+	if _, err := openGELFOutput("gelf+quic://host:1"); err == nil {
+		t.Error("openGELFOutput accepted an unknown scheme")
+	}
+}
@@ -0,0 +1,47 @@
+package logging
+
+import (
+	"context"
+	"log/slog"
+	"time"
+)
+
+// Record is the mutable form of a log record seen by hooks, before it is
+// handed to the handler.
+type Record struct {
+	// Time is the record timestamp.
+	Time time.Time
+	// Level is the record severity.
+	Level Level
+	// Message is the record message.
+	Message string
+	// Attrs are the record's attributes, in call order.
+	Attrs []slog.Attr
+	// PC is the program counter of the logging call site, when captured.
+	PC uintptr
+}
+
+// Hook runs before a record reaches the handler. It may return a modified
+// record; returning false drops the record entirely. Hooks run in
+// registration order, so enrichment, filtering and alerting can be layered
+// without a custom handler.
+type Hook func(ctx context.Context, r Record) (Record, bool)
+
+// AddHook appends a hook to the logger's chain. Hooks added after With
+// clones were taken are not seen by those clones.
+func (l *SlogLogger) AddHook(h Hook) {
+	l.hooks = append(l.hooks, h)
+}
+
+// runHooks passes rec through the hook chain; the second result is false
+// when a hook dropped the record.
+func runHooks(ctx context.Context, hooks []Hook, rec Record) (Record, bool) {
+	for _, h := range hooks {
+		var keep bool
+		rec, keep = h(ctx, rec)
+		if !keep {
+			return rec, false
+		}
+	}
+	return rec, true
+}
@@ -0,0 +1,31 @@
+package logging
+
+import (
+	"log"
+	"strings"
+)
+
+// NewHTTPErrorLog returns a *log.Logger suitable for http.Server.ErrorLog
+// that emits through l instead of stderr, so TLS handshake noise and
+// panics from net/http reach the structured output. Recovered handler
+// panics log at Error; everything else net/http reports (handshake
+// failures, header read errors, suspicious requests) logs at Warn.
+func NewHTTPErrorLog(l Logger) *log.Logger {
+	return log.New(&httpErrorWriter{l: l}, "", 0)
+}
+
+// httpErrorWriter receives one line per server error.
+type httpErrorWriter struct {
+	l Logger
+}
+
+// Write implements io.Writer.
+func (w *httpErrorWriter) Write(p []byte) (int, error) {
+	msg := strings.TrimRight(string(p), "\n")
+	if strings.Contains(msg, "panic") {
+		w.l.Error(msg)
+	} else {
+		w.l.Warn(msg)
+	}
+	return len(p), nil
+}
@@ -0,0 +1,77 @@
+package logging
+
+import (
+	"fmt"
+)
+
+// SaramaLogger implements sarama.StdLogger over the Logger interface, so
+// the Kafka client's internals land in structured output:
+//
+//	sarama.Logger = logging.NewSaramaLogger(logger)
+//
+// sarama's messages are chatty connection-lifecycle noise, so they log at
+// LevelDebug.
+type SaramaLogger struct {
+	l Logger
+}
+
+// NewSaramaLogger wraps l for sarama.Logger.
+func NewSaramaLogger(l Logger) *SaramaLogger {
+	return &SaramaLogger{l: l}
+}
+
+// Print implements sarama.StdLogger.
+func (s *SaramaLogger) Print(v ...any) { s.l.Debug(fmt.Sprint(v...)) }
+
+// Printf implements sarama.StdLogger.
+func (s *SaramaLogger) Printf(format string, v ...any) { s.l.Debug(fmt.Sprintf(format, v...)) }
+
+// Println implements sarama.StdLogger.
+func (s *SaramaLogger) Println(v ...any) { s.l.Debug(sprintln(v...)) }
+
+// franz-go log levels, matching kgo.LogLevel's values.
+const (
+	KafkaLogLevelNone = iota
+	KafkaLogLevelError
+	KafkaLogLevelWarn
+	KafkaLogLevelInfo
+	KafkaLogLevelDebug
+)
+
+// KafkaGoLogger is a franz-go-style leveled logger. kgo.Logger's
+// signature uses the concrete kgo.LogLevel type, so wire it with a
+// two-line shim in the application:
+//
+//	type kgoShim struct{ *logging.KafkaGoLogger }
+//
+//	func (s kgoShim) Level() kgo.LogLevel { return kgo.LogLevel(s.KafkaGoLogger.Level()) }
+//	func (s kgoShim) Log(lv kgo.LogLevel, msg string, kv ...any) {
+//		s.KafkaGoLogger.Log(int(lv), msg, kv...)
+//	}
+type KafkaGoLogger struct {
+	l     Logger
+	level int
+}
+
+// NewKafkaGoLogger wraps l, reporting maxLevel (one of the KafkaLogLevel
+// constants) as the client's verbosity ceiling.
+func NewKafkaGoLogger(l Logger, maxLevel int) *KafkaGoLogger {
+	return &KafkaGoLogger{l: l, level: maxLevel}
+}
+
+// Level reports the maximum level the client should bother emitting.
+func (k *KafkaGoLogger) Level() int { return k.level }
+
+// Log routes one client record into the Logger.
+func (k *KafkaGoLogger) Log(level int, msg string, keyvals ...any) {
+	switch level {
+	case KafkaLogLevelError:
+		k.l.Error(msg, keyvals...)
+	case KafkaLogLevelWarn:
+		k.l.Warn(msg, keyvals...)
+	case KafkaLogLevelInfo:
+		k.l.Info(msg, keyvals...)
+	case KafkaLogLevelDebug:
+		k.l.Debug(msg, keyvals...)
+	}
+}
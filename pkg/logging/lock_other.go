@@ -0,0 +1,9 @@
+//go:build !unix
+
+package logging
+
+// acquireFileLock is a no-op on platforms without flock; rotation falls
+// back to uncoordinated behavior.
+func acquireFileLock(path string) (release func()) {
+	return func() {}
+}
@@ -0,0 +1,27 @@
+//go:build unix
+
+package logging
+
+import (
+	"os"
+	"syscall"
+)
+
+// acquireFileLock takes an exclusive advisory flock on path, creating the
+// lock file if needed. The returned release function unlocks and closes
+// it. A nil release means the lock could not be taken; callers proceed
+// uncoordinated in that case.
+func acquireFileLock(path string) (release func()) {
+	f, err := os.OpenFile(path, os.O_CREATE|os.O_RDWR, 0o644)
+	if err != nil {
+		return nil
+	}
+	if err := syscall.Flock(int(f.Fd()), syscall.LOCK_EX); err != nil {
+		f.Close()
+		return nil
+	}
+	return func() {
+		syscall.Flock(int(f.Fd()), syscall.LOCK_UN)
+		f.Close()
+	}
+}
@@ -0,0 +1,74 @@
+// Package logging provides the project's structured logging facilities,
+// built on log/slog with optional daily file rotation.
+package logging
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"strings"
+)
+
+// Level is the severity of a log record.
+type Level = slog.Level
+
+// Supported levels.
+const (
+	LevelDebug = slog.LevelDebug
+	LevelInfo  = slog.LevelInfo
+	LevelWarn  = slog.LevelWarn
+	LevelError = slog.LevelError
+)
+
+// ParseLevel converts a level name such as "debug" or "WARN" into a Level.
+func ParseLevel(s string) (Level, error) {
+	switch strings.ToLower(strings.TrimSpace(s)) {
+	case "", "info":
+		return LevelInfo, nil
+	case "debug":
+		return LevelDebug, nil
+	case "warn", "warning":
+		return LevelWarn, nil
+	case "error":
+		return LevelError, nil
+	}
+	return LevelInfo, fmt.Errorf("logging: unknown level %q", s)
+}
+
+// Logger is the leveled, structured logging interface used across the
+// project. Arguments follow slog conventions: alternating key/value pairs
+// or slog.Attr values.
+type Logger interface {
+	Debug(msg string, args ...any)
+	Info(msg string, args ...any)
+	Warn(msg string, args ...any)
+	Error(msg string, args ...any)
+
+	// With returns a Logger that includes the given attributes in every
+	// record.
+	With(args ...any) Logger
+
+	// WithGroup returns a Logger that nests subsequent attributes under
+	// the named group, producing proper sub-objects in structured output.
+	WithGroup(name string) Logger
+
+	// WithError returns a Logger that includes err under the canonical
+	// "error" key in every record; a nil err returns the receiver.
+	WithError(err error) Logger
+
+	// WithContext returns a Logger that associates ctx with emitted
+	// records.
+	WithContext(ctx context.Context) Logger
+}
+
+// Group builds a nested attribute group inline, e.g.
+// logger.Info("request done", logging.Group("http", "method", m, "status", s)).
+func Group(name string, args ...any) slog.Attr {
+	return slog.Group(name, args...)
+}
+
+// Syncer is implemented by loggers and writers that buffer output and can
+// force it to disk. Callers should flush before process exit.
+type Syncer interface {
+	Flush(ctx context.Context) error
+}
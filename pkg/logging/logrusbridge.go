@@ -0,0 +1,95 @@
+package logging
+
+import (
+	"bytes"
+	"encoding/json"
+	"io"
+	"sort"
+	"strings"
+	"sync"
+)
+
+// LogrusWriter is an io.Writer for logrus's Out that forwards entries
+// into the Logger pipeline. Point logrus at it with its JSON formatter:
+//
+//	logrus.SetFormatter(&logrus.JSONFormatter{})
+//	logrus.SetOutput(logging.NewLogrusWriter(logger))
+//
+// Each JSON line is decoded and re-emitted through the Logger, so legacy
+// modules still on logrus land in the same rotated files with the same
+// schema. Lines that do not parse as JSON are logged verbatim at Info.
+type LogrusWriter struct {
+	l   Logger
+	mu  sync.Mutex
+	buf bytes.Buffer
+}
+
+var _ io.Writer = (*LogrusWriter)(nil)
+
+// NewLogrusWriter returns a writer forwarding into l.
+func NewLogrusWriter(l Logger) *LogrusWriter {
+	return &LogrusWriter{l: l}
+}
+
+// Write implements io.Writer, buffering until complete lines arrive.
+func (w *LogrusWriter) Write(p []byte) (int, error) {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	w.buf.Write(p)
+	for {
+		line, err := w.buf.ReadString('\n')
+		if err != nil {
+			// Partial line: keep it buffered for the next Write.
+			w.buf.WriteString(line)
+			break
+		}
+		w.emit(strings.TrimRight(line, "\n"))
+	}
+	return len(p), nil
+}
+
+// emit decodes one logrus JSON line and re-logs it.
+func (w *LogrusWriter) emit(line string) {
+	if line == "" {
+		return
+	}
+	var entry map[string]any
+	if err := json.Unmarshal([]byte(line), &entry); err != nil {
+		w.l.Info(line)
+		return
+	}
+	level := LevelInfo
+	if s, ok := entry["level"].(string); ok {
+		switch strings.ToLower(s) {
+		case "fatal", "panic":
+			level = LevelError
+		default:
+			if parsed, err := ParseLevel(s); err == nil {
+				level = parsed
+			}
+		}
+	}
+	msg, _ := entry["msg"].(string)
+	delete(entry, "level")
+	delete(entry, "msg")
+	delete(entry, "time")
+	keys := make([]string, 0, len(entry))
+	for k := range entry {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	args := make([]any, 0, len(keys)*2)
+	for _, k := range keys {
+		args = append(args, k, entry[k])
+	}
+	switch {
+	case level >= LevelError:
+		w.l.Error(msg, args...)
+	case level >= LevelWarn:
+		w.l.Warn(msg, args...)
+	case level >= LevelInfo:
+		w.l.Info(msg, args...)
+	default:
+		w.l.Debug(msg, args...)
+	}
+}
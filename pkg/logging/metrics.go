@@ -0,0 +1,96 @@
+package logging
+
+import (
+	"fmt"
+	"net/http"
+	"sort"
+	"sync"
+	"sync/atomic"
+)
+
+// logMetrics aggregates package-wide counters in a form that can be
+// scraped without a Prometheus client dependency: records per level,
+// bytes written through rotate writers, write errors, records dropped by
+// hooks, and rotations.
+var logMetrics = struct {
+	mu          sync.Mutex
+	records     map[string]*atomic.Uint64
+	bytes       atomic.Uint64
+	writeErrors atomic.Uint64
+	dropped     atomic.Uint64
+	rotations   atomic.Uint64
+	reconnects  atomic.Uint64
+	buffered    atomic.Uint64
+}{records: map[string]*atomic.Uint64{}}
+
+// metricRecord counts one emitted record at level.
+func metricRecord(level Level) {
+	key := level.String()
+	logMetrics.mu.Lock()
+	c, ok := logMetrics.records[key]
+	if !ok {
+		c = &atomic.Uint64{}
+		logMetrics.records[key] = c
+	}
+	logMetrics.mu.Unlock()
+	c.Add(1)
+}
+
+// metricBytes counts bytes successfully written to a log file.
+func metricBytes(n int) { logMetrics.bytes.Add(uint64(n)) }
+
+// metricWriteError counts one failed handler or writer operation.
+func metricWriteError() { logMetrics.writeErrors.Add(1) }
+
+// metricDropped counts one record dropped by the hook chain.
+func metricDropped() { logMetrics.dropped.Add(1) }
+
+// metricRotation counts one completed file rotation.
+func metricRotation() { logMetrics.rotations.Add(1) }
+
+// metricReconnect counts one re-established network sink connection.
+func metricReconnect() { logMetrics.reconnects.Add(1) }
+
+// metricBuffered counts one record held back during a network outage.
+func metricBuffered() { logMetrics.buffered.Add(1) }
+
+// MetricsHandler returns an http.Handler serving the logging counters in
+// Prometheus text exposition format, so "error log rate" alerting works
+// without parsing files:
+//
+//	mux.Handle("/metrics/logging", logging.MetricsHandler())
+func MetricsHandler() http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "text/plain; version=0.0.4")
+		fmt.Fprintln(w, "# HELP yuango_log_records_total Log records emitted, by level.")
+		fmt.Fprintln(w, "# TYPE yuango_log_records_total counter")
+		logMetrics.mu.Lock()
+		levels := make([]string, 0, len(logMetrics.records))
+		for level := range logMetrics.records {
+			levels = append(levels, level)
+		}
+		sort.Strings(levels)
+		for _, level := range levels {
+			fmt.Fprintf(w, "yuango_log_records_total{level=%q} %d\n", level, logMetrics.records[level].Load())
+		}
+		logMetrics.mu.Unlock()
+		fmt.Fprintln(w, "# HELP yuango_log_bytes_written_total Bytes written to log files.")
+		fmt.Fprintln(w, "# TYPE yuango_log_bytes_written_total counter")
+		fmt.Fprintf(w, "yuango_log_bytes_written_total %d\n", logMetrics.bytes.Load())
+		fmt.Fprintln(w, "# HELP yuango_log_write_errors_total Failed log writes.")
+		fmt.Fprintln(w, "# TYPE yuango_log_write_errors_total counter")
+		fmt.Fprintf(w, "yuango_log_write_errors_total %d\n", logMetrics.writeErrors.Load())
+		fmt.Fprintln(w, "# HELP yuango_log_records_dropped_total Records dropped by hooks or sampling.")
+		fmt.Fprintln(w, "# TYPE yuango_log_records_dropped_total counter")
+		fmt.Fprintf(w, "yuango_log_records_dropped_total %d\n", logMetrics.dropped.Load())
+		fmt.Fprintln(w, "# HELP yuango_log_rotations_total Completed log file rotations.")
+		fmt.Fprintln(w, "# TYPE yuango_log_rotations_total counter")
+		fmt.Fprintf(w, "yuango_log_rotations_total %d\n", logMetrics.rotations.Load())
+		fmt.Fprintln(w, "# HELP yuango_log_reconnects_total Re-established network sink connections.")
+		fmt.Fprintln(w, "# TYPE yuango_log_reconnects_total counter")
+		fmt.Fprintf(w, "yuango_log_reconnects_total %d\n", logMetrics.reconnects.Load())
+		fmt.Fprintln(w, "# HELP yuango_log_records_buffered_total Records buffered during network outages.")
+		fmt.Fprintln(w, "# TYPE yuango_log_records_buffered_total counter")
+		fmt.Fprintf(w, "yuango_log_records_buffered_total %d\n", logMetrics.buffered.Load())
+	})
+}
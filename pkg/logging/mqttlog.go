@@ -0,0 +1,160 @@
+package logging
+
+import (
+	"context"
+	"log/slog"
+	"strings"
+	"sync"
+)
+
+// defaultMQTTBufferRecords bounds the offline buffer when unset.
+const defaultMQTTBufferRecords = 256
+
+// MQTTPublisher is the slice of an MQTT client the sink needs. Wrap the
+// paho client in a shim that waits on its token:
+//
+//	type pahoPub struct{ c mqtt.Client }
+//
+//	func (p pahoPub) Publish(topic string, qos byte, retained bool, payload []byte) error {
+//		t := p.c.Publish(topic, qos, retained, payload)
+//		t.Wait()
+//		return t.Error()
+//	}
+type MQTTPublisher interface {
+	Publish(topic string, qos byte, retained bool, payload []byte) error
+}
+
+// MQTTSinkConfig controls the MQTT forwarding hook.
+type MQTTSinkConfig struct {
+	// Topic is the publish topic. "{level}" and "{attr:key}" placeholders
+	// are filled per record as in the NATS sink, with "/" kept as the
+	// separator, e.g. "devices/{attr:device}/logs/{level}". Empty means
+	// "logs/{level}".
+	Topic string
+	// QoS is the MQTT quality of service (0, 1 or 2).
+	QoS byte
+	// Retained marks published messages as retained, so a subscriber
+	// joining later sees the last record per topic.
+	Retained bool
+	// MinLevel is the lowest level published; nil means LevelDebug, so a
+	// MinLevel of LevelInfo is expressible.
+	MinLevel slog.Leveler
+	// BufferRecords is how many records to hold while the broker is
+	// unreachable, replayed in order on the next successful publish;
+	// 0 means 256. The oldest records are dropped beyond it.
+	BufferRecords int
+}
+
+// MQTTHook publishes records to an MQTT topic so embedded and edge
+// devices can report logs over the broker they already use. Publish
+// failures buffer the record for replay instead of blocking or losing it
+// immediately; records always continue to the normal output.
+type MQTTHook struct {
+	pub MQTTPublisher
+	cfg MQTTSinkConfig
+
+	mu      sync.Mutex
+	pending []mqttMessage
+}
+
+// mqttMessage is one buffered publish.
+type mqttMessage struct {
+	topic   string
+	payload []byte
+}
+
+// NewMQTTHook returns the hook.
+func NewMQTTHook(pub MQTTPublisher, cfg MQTTSinkConfig) *MQTTHook {
+	if cfg.Topic == "" {
+		cfg.Topic = "logs/{level}"
+	}
+	if cfg.MinLevel == nil {
+		cfg.MinLevel = LevelDebug
+	}
+	if cfg.BufferRecords <= 0 {
+		cfg.BufferRecords = defaultMQTTBufferRecords
+	}
+	return &MQTTHook{pub: pub, cfg: cfg}
+}
+
+// Hook returns the forwarding hook for AddHook or Config.Hooks.
+func (h *MQTTHook) Hook() Hook {
+	return func(ctx context.Context, r Record) (Record, bool) {
+		if r.Level >= h.cfg.MinLevel.Level() {
+			if payload := natsPayload(r); payload != nil {
+				h.publish(mqttMessage{topic: h.topic(r), payload: payload})
+			}
+		}
+		return r, true
+	}
+}
+
+// publish drains any offline backlog, then sends msg, buffering it when
+// the broker is unreachable.
+func (h *MQTTHook) publish(msg mqttMessage) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	for len(h.pending) > 0 {
+		head := h.pending[0]
+		if err := h.pub.Publish(head.topic, h.cfg.QoS, h.cfg.Retained, head.payload); err != nil {
+			h.bufferLocked(msg)
+			return
+		}
+		h.pending = h.pending[1:]
+	}
+	if err := h.pub.Publish(msg.topic, h.cfg.QoS, h.cfg.Retained, msg.payload); err != nil {
+		h.bufferLocked(msg)
+	}
+}
+
+// bufferLocked queues one message for replay, dropping the oldest when
+// the buffer is full. Callers hold h.mu.
+func (h *MQTTHook) bufferLocked(msg mqttMessage) {
+	metricBuffered()
+	if len(h.pending) >= h.cfg.BufferRecords {
+		h.pending = h.pending[1:]
+		metricDropped()
+	}
+	h.pending = append(h.pending, msg)
+}
+
+// topic resolves the configured topic template against r.
+func (h *MQTTHook) topic(r Record) string {
+	topic := h.cfg.Topic
+	if strings.Contains(topic, "{level}") {
+		topic = strings.ReplaceAll(topic, "{level}", mqttToken(r.Level.String()))
+	}
+	for {
+		start := strings.Index(topic, "{attr:")
+		if start < 0 {
+			break
+		}
+		end := strings.Index(topic[start:], "}")
+		if end < 0 {
+			break
+		}
+		key := topic[start+len("{attr:") : start+end]
+		value := "unknown"
+		for _, a := range r.Attrs {
+			if a.Key == key {
+				value = mqttToken(a.Value.Resolve().String())
+				break
+			}
+		}
+		topic = topic[:start] + value + topic[start+end+1:]
+	}
+	return topic
+}
+
+// mqttToken makes a value safe as a single topic level: lowercase, with
+// the protocol's separator and wildcard characters replaced.
+func mqttToken(s string) string {
+	s = strings.ToLower(s)
+	return strings.Map(func(r rune) rune {
+		switch r {
+		case '/', '+', '#', ' ':
+			return '-'
+		}
+		return r
+	}, s)
+}
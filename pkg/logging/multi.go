@@ -0,0 +1,76 @@
+package logging
+
+import (
+	"context"
+	"errors"
+	"io"
+	"os"
+	"strings"
+)
+
+// MultiWriter fans every write out to several destinations, e.g. stdout
+// plus a daily-rotated file. Unlike io.MultiWriter it keeps writing to the
+// remaining destinations when one fails and reports the joined errors.
+type MultiWriter struct {
+	writers []io.Writer
+}
+
+// NewMultiWriter returns a MultiWriter over the given destinations.
+func NewMultiWriter(writers ...io.Writer) *MultiWriter {
+	return &MultiWriter{writers: writers}
+}
+
+// Write writes p to every destination. It reports len(p) and the joined
+// errors of any destinations that failed.
+func (m *MultiWriter) Write(p []byte) (int, error) {
+	var errs []error
+	for _, w := range m.writers {
+		if _, err := w.Write(p); err != nil {
+			errs = append(errs, err)
+		}
+	}
+	return len(p), errors.Join(errs...)
+}
+
+// Flush flushes every destination that supports flushing.
+func (m *MultiWriter) Flush(ctx context.Context) error {
+	var errs []error
+	for _, w := range m.writers {
+		switch out := w.(type) {
+		case Syncer:
+			errs = append(errs, out.Flush(ctx))
+		case interface{ Sync() error }:
+			errs = append(errs, out.Sync())
+		}
+	}
+	return errors.Join(errs...)
+}
+
+// Close closes every destination that is an io.Closer, except the
+// process's stdout and stderr, which are not the writer's to close —
+// mirroring the single-output ownership guard in NewFromConfig.
+func (m *MultiWriter) Close() error {
+	var errs []error
+	for _, w := range m.writers {
+		if w == os.Stdout || w == os.Stderr {
+			continue
+		}
+		if c, ok := w.(io.Closer); ok {
+			errs = append(errs, c.Close())
+		}
+	}
+	return errors.Join(errs...)
+}
+
+// splitOutputs splits a comma-separated Output value such as
+// "stdout,/var/log/app.log" into its entries.
+func splitOutputs(output string) []string {
+	parts := strings.Split(output, ",")
+	outputs := make([]string, 0, len(parts))
+	for _, p := range parts {
+		if p = strings.TrimSpace(p); p != "" {
+			outputs = append(outputs, p)
+		}
+	}
+	return outputs
+}
@@ -0,0 +1,129 @@
+package logging
+
+import (
+	"context"
+	"encoding/json"
+	"log/slog"
+	"strings"
+)
+
+// NATSPublisher is the slice of the NATS client the sink needs.
+// *nats.Conn satisfies it directly:
+//
+//	hook := logging.NewNATSHook(nc, logging.NATSSinkConfig{Subject: "logs.{level}"})
+//	logger.AddHook(hook.Hook())
+//
+// JetStream's Publish returns an ack, so wrap it in a two-line shim for
+// durable streams:
+//
+//	type jsPub struct{ js nats.JetStreamContext }
+//
+//	func (p jsPub) Publish(subject string, data []byte) error {
+//		_, err := p.js.Publish(subject, data)
+//		return err
+//	}
+type NATSPublisher interface {
+	Publish(subject string, data []byte) error
+}
+
+// NATSSinkConfig controls the NATS forwarding hook.
+type NATSSinkConfig struct {
+	// Subject is the publish subject. "{level}" and "{attr:key}"
+	// placeholders are filled per record (lowercased, dots stripped), so
+	// consumers can subscribe to e.g. "logs.error.>" or split by service:
+	// "logs.{attr:service}.{level}". Empty means "logs.{level}".
+	Subject string
+
+	// MinLevel is the lowest level forwarded; records below it pass
+	// through to the normal output only. nil means LevelDebug, so a
+	// MinLevel of LevelInfo is expressible.
+	MinLevel slog.Leveler
+}
+
+// NATSHook publishes records as JSON to NATS subjects, for teams using
+// NATS as their event backbone. Records always continue to the normal
+// output; publish failures are dropped silently.
+type NATSHook struct {
+	pub NATSPublisher
+	cfg NATSSinkConfig
+}
+
+// NewNATSHook returns the hook.
+func NewNATSHook(pub NATSPublisher, cfg NATSSinkConfig) *NATSHook {
+	if cfg.Subject == "" {
+		cfg.Subject = "logs.{level}"
+	}
+	if cfg.MinLevel == nil {
+		cfg.MinLevel = LevelDebug
+	}
+	return &NATSHook{pub: pub, cfg: cfg}
+}
+
+// Hook returns the forwarding hook for AddHook or Config.Hooks.
+func (h *NATSHook) Hook() Hook {
+	return func(ctx context.Context, r Record) (Record, bool) {
+		if r.Level >= h.cfg.MinLevel.Level() {
+			if data := natsPayload(r); data != nil {
+				h.pub.Publish(h.subject(r), data)
+			}
+		}
+		return r, true
+	}
+}
+
+// subject resolves the configured subject template against r.
+func (h *NATSHook) subject(r Record) string {
+	subj := h.cfg.Subject
+	if strings.Contains(subj, "{level}") {
+		subj = strings.ReplaceAll(subj, "{level}", natsToken(r.Level.String()))
+	}
+	for {
+		start := strings.Index(subj, "{attr:")
+		if start < 0 {
+			break
+		}
+		end := strings.Index(subj[start:], "}")
+		if end < 0 {
+			break
+		}
+		key := subj[start+len("{attr:") : start+end]
+		value := "unknown"
+		for _, a := range r.Attrs {
+			if a.Key == key {
+				value = natsToken(a.Value.Resolve().String())
+				break
+			}
+		}
+		subj = subj[:start] + value + subj[start+end+1:]
+	}
+	return subj
+}
+
+// natsToken makes a value safe as a single subject token: lowercase, with
+// the protocol's separator and wildcard characters replaced.
+func natsToken(s string) string {
+	s = strings.ToLower(s)
+	return strings.Map(func(r rune) rune {
+		switch r {
+		case '.', '*', '>', ' ':
+			return '-'
+		}
+		return r
+	}, s)
+}
+
+// natsPayload serializes r in the same JSON-line shape the Recorder uses.
+func natsPayload(r Record) []byte {
+	entry := recordedEntry{Time: r.Time, Level: r.Level.String(), Msg: r.Message}
+	if len(r.Attrs) > 0 {
+		entry.Attrs = make(map[string]any, len(r.Attrs))
+		for _, a := range r.Attrs {
+			entry.Attrs[a.Key] = a.Value.Resolve().Any()
+		}
+	}
+	data, err := json.Marshal(entry)
+	if err != nil {
+		return nil
+	}
+	return data
+}
@@ -0,0 +1,224 @@
+package logging
+
+import (
+	"bufio"
+	"fmt"
+	"net"
+	"net/url"
+	"os"
+	"strings"
+	"sync"
+)
+
+// NetworkSinkConfig configures a NetworkWriter.
+type NetworkSinkConfig struct {
+	// Network is "tcp" or "udp".
+	Network string
+
+	// Addr is the host:port endpoint.
+	Addr string
+
+	// BufferRecords is how many records to hold in memory while the
+	// endpoint is unreachable; 0 means 1024. When the buffer is full the
+	// oldest record is dropped.
+	BufferRecords int
+
+	// SpoolFile, when set, appends records that overflow the in-memory
+	// buffer to this file; they are replayed once the connection comes
+	// back, so long outages survive a bounded memory budget.
+	SpoolFile string
+}
+
+// defaultNetBufferRecords bounds the outage buffer when unset.
+const defaultNetBufferRecords = 1024
+
+// NetworkWriter ships newline-delimited records to a TCP or UDP endpoint.
+// Writes never fail the caller on a connection outage: records queue in a
+// bounded in-memory buffer (optionally spilling to a spool file) and are
+// replayed in order once the endpoint is reachable again. Reconnects and
+// buffered records are visible through MetricsHandler.
+type NetworkWriter struct {
+	cfg NetworkSinkConfig
+
+	mu      sync.Mutex
+	conn    net.Conn
+	pending [][]byte
+	spooled bool
+}
+
+// NewNetworkWriter returns a writer for the given endpoint. The first
+// write dials; construction never fails on an unreachable endpoint.
+func NewNetworkWriter(cfg NetworkSinkConfig) (*NetworkWriter, error) {
+	if cfg.Network != "tcp" && cfg.Network != "udp" {
+		return nil, fmt.Errorf("logging: network sink supports tcp or udp, not %q", cfg.Network)
+	}
+	if cfg.Addr == "" {
+		return nil, fmt.Errorf("logging: network sink requires an address")
+	}
+	if cfg.BufferRecords <= 0 {
+		cfg.BufferRecords = defaultNetBufferRecords
+	}
+	return &NetworkWriter{cfg: cfg}, nil
+}
+
+// openNetworkOutput resolves a "tcp://" or "udp://" output URL.
+func openNetworkOutput(out string) (*NetworkWriter, error) {
+	u, err := url.Parse(out)
+	if err != nil {
+		return nil, fmt.Errorf("logging: bad network output %q: %w", out, err)
+	}
+	return NewNetworkWriter(NetworkSinkConfig{Network: u.Scheme, Addr: u.Host})
+}
+
+// Write implements io.Writer. p is one encoded record; a trailing newline
+// is added when missing so the stream stays line-delimited.
+func (w *NetworkWriter) Write(p []byte) (int, error) {
+	line := make([]byte, len(p), len(p)+1)
+	copy(line, p)
+	if len(line) == 0 || line[len(line)-1] != '\n' {
+		line = append(line, '\n')
+	}
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	if err := w.connectLocked(); err != nil {
+		w.bufferLocked(line)
+		return len(p), nil
+	}
+	if err := w.drainLocked(); err != nil {
+		w.bufferLocked(line)
+		return len(p), nil
+	}
+	if _, err := w.conn.Write(line); err != nil {
+		w.dropConnLocked()
+		// One immediate retry covers the common half-closed TCP case.
+		if err := w.connectLocked(); err == nil {
+			if _, err := w.conn.Write(line); err == nil {
+				return len(p), nil
+			}
+			w.dropConnLocked()
+		}
+		w.bufferLocked(line)
+	}
+	return len(p), nil
+}
+
+// connectLocked dials if needed. Callers hold w.mu.
+func (w *NetworkWriter) connectLocked() error {
+	if w.conn != nil {
+		return nil
+	}
+	conn, err := net.Dial(w.cfg.Network, w.cfg.Addr)
+	if err != nil {
+		return err
+	}
+	w.conn = conn
+	metricReconnect()
+	return nil
+}
+
+// dropConnLocked discards a broken connection. Callers hold w.mu.
+func (w *NetworkWriter) dropConnLocked() {
+	if w.conn != nil {
+		w.conn.Close()
+		w.conn = nil
+	}
+}
+
+// bufferLocked queues one record for replay, spilling to the spool file
+// or dropping the oldest record when the in-memory buffer is full.
+// Callers hold w.mu.
+func (w *NetworkWriter) bufferLocked(line []byte) {
+	metricBuffered()
+	if len(w.pending) >= w.cfg.BufferRecords {
+		if w.cfg.SpoolFile != "" && w.spoolLocked(line) == nil {
+			return
+		}
+		w.pending = w.pending[1:]
+		metricDropped()
+	}
+	w.pending = append(w.pending, line)
+}
+
+// spoolLocked appends one record to the on-disk spool. Callers hold w.mu.
+func (w *NetworkWriter) spoolLocked(line []byte) error {
+	f, err := os.OpenFile(w.cfg.SpoolFile, os.O_CREATE|os.O_APPEND|os.O_WRONLY, 0o644)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+	if _, err := f.Write(line); err != nil {
+		return err
+	}
+	w.spooled = true
+	return nil
+}
+
+// drainLocked replays buffered and spooled records in original order over
+// the live connection. Callers hold w.mu with w.conn set.
+func (w *NetworkWriter) drainLocked() error {
+	for len(w.pending) > 0 {
+		if _, err := w.conn.Write(w.pending[0]); err != nil {
+			w.dropConnLocked()
+			return err
+		}
+		w.pending = w.pending[1:]
+	}
+	if w.spooled {
+		return w.drainSpoolLocked()
+	}
+	return nil
+}
+
+// drainSpoolLocked replays and removes the on-disk spool. Callers hold
+// w.mu with w.conn set and the in-memory buffer empty.
+func (w *NetworkWriter) drainSpoolLocked() error {
+	f, err := os.Open(w.cfg.SpoolFile)
+	if err != nil {
+		if os.IsNotExist(err) {
+			w.spooled = false
+			return nil
+		}
+		return err
+	}
+	scanner := bufio.NewScanner(f)
+	scanner.Buffer(make([]byte, 0, 64*1024), 1024*1024)
+	for scanner.Scan() {
+		line := scanner.Text()
+		if strings.TrimSpace(line) == "" {
+			continue
+		}
+		if _, err := fmt.Fprintln(w.conn, line); err != nil {
+			f.Close()
+			w.dropConnLocked()
+			return err
+		}
+	}
+	f.Close()
+	if err := scanner.Err(); err != nil {
+		return err
+	}
+	os.Remove(w.cfg.SpoolFile)
+	w.spooled = false
+	return nil
+}
+
+// Close implements io.Closer, spilling anything still buffered to the
+// spool file when one is configured.
+func (w *NetworkWriter) Close() error {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	if w.cfg.SpoolFile != "" {
+		for len(w.pending) > 0 {
+			if w.spoolLocked(w.pending[0]) != nil {
+				break
+			}
+			w.pending = w.pending[1:]
+		}
+	}
+	if w.conn == nil {
+		return nil
+	}
+	err := w.conn.Close()
+	w.conn = nil
+	return err
+}
@@ -0,0 +1,59 @@
+package logging
+
+import (
+	"context"
+	"log/slog"
+	"sync"
+	"sync/atomic"
+)
+
+// TraceContext identifies the active span for log/trace correlation.
+type TraceContext struct {
+	TraceID string
+	SpanID  string
+}
+
+// traceExtractor holds the installed extractor; the package has no
+// OpenTelemetry dependency, so applications bridge it once at startup.
+var traceExtractor atomic.Value // of func(context.Context) (TraceContext, bool)
+
+var traceExtractorOnce sync.Once
+
+// SetTraceExtractor installs the function that reads the active span from
+// a context. Once installed, every record logged through WithContext with
+// an active span automatically carries "trace_id" and "span_id"
+// attributes. Wire it to the OpenTelemetry SDK in the application:
+//
+//	logging.SetTraceExtractor(func(ctx context.Context) (logging.TraceContext, bool) {
+//		sc := trace.SpanContextFromContext(ctx)
+//		if !sc.IsValid() {
+//			return logging.TraceContext{}, false
+//		}
+//		return logging.TraceContext{
+//			TraceID: sc.TraceID().String(),
+//			SpanID:  sc.SpanID().String(),
+//		}, true
+//	})
+func SetTraceExtractor(fn func(ctx context.Context) (TraceContext, bool)) {
+	traceExtractor.Store(fn)
+	traceExtractorOnce.Do(func() {
+		RegisterContextExtractor(traceAttrs)
+	})
+}
+
+// traceAttrs is the context extractor that surfaces the installed trace
+// extractor's IDs as attributes.
+func traceAttrs(ctx context.Context) []slog.Attr {
+	fn, _ := traceExtractor.Load().(func(ctx context.Context) (TraceContext, bool))
+	if fn == nil {
+		return nil
+	}
+	tc, ok := fn(ctx)
+	if !ok {
+		return nil
+	}
+	return []slog.Attr{
+		slog.String("trace_id", tc.TraceID),
+		slog.String("span_id", tc.SpanID),
+	}
+}
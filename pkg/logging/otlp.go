@@ -0,0 +1,213 @@
+package logging
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"log/slog"
+	"net/http"
+	"strings"
+	"sync"
+	"time"
+)
+
+const (
+	// otlpFlushInterval is how often buffered records are exported.
+	otlpFlushInterval = 2 * time.Second
+	// otlpMaxBatch exports early once this many records are buffered.
+	otlpMaxBatch = 512
+)
+
+// OTLPHandler converts records to OpenTelemetry log records and ships them
+// to an OTLP/HTTP collector endpoint ("<endpoint>/v1/logs") using the JSON
+// protocol encoding, so logs join traces and metrics in the same
+// collector. Records are batched and exported asynchronously.
+type OTLPHandler struct {
+	endpoint string
+	headers  map[string]string
+	level    slog.Leveler
+	client   *http.Client
+	attrs    []slog.Attr
+	groups   []string
+	batch    *otlpBatch
+}
+
+// otlpBatch is the export buffer shared by an OTLPHandler and its
+// WithAttrs/WithGroup clones.
+type otlpBatch struct {
+	mu      sync.Mutex
+	pending []map[string]any
+	timer   *time.Timer
+}
+
+// NewOTLPHandler returns an OTLPHandler exporting to endpoint, e.g.
+// "http://localhost:4318". headers may carry authentication and may be
+// nil.
+func NewOTLPHandler(endpoint string, level slog.Leveler, headers map[string]string) *OTLPHandler {
+	return &OTLPHandler{
+		endpoint: strings.TrimRight(endpoint, "/"),
+		headers:  headers,
+		level:    level,
+		client:   &http.Client{Timeout: 30 * time.Second},
+		batch:    &otlpBatch{},
+	}
+}
+
+// Enabled implements slog.Handler.
+func (h *OTLPHandler) Enabled(_ context.Context, level slog.Level) bool {
+	min := slog.Level(LevelInfo)
+	if h.level != nil {
+		min = h.level.Level()
+	}
+	return level >= min
+}
+
+// Handle implements slog.Handler.
+func (h *OTLPHandler) Handle(_ context.Context, r slog.Record) error {
+	attrs := make([]map[string]any, 0, len(h.attrs)+r.NumAttrs())
+	for _, a := range h.attrs {
+		attrs = append(attrs, otlpAttr(a))
+	}
+	prefix := strings.Join(h.groups, ".")
+	r.Attrs(func(a slog.Attr) bool {
+		if prefix != "" {
+			a.Key = prefix + "." + a.Key
+		}
+		attrs = append(attrs, otlpAttr(a))
+		return true
+	})
+	record := map[string]any{
+		"timeUnixNano":   fmt.Sprintf("%d", r.Time.UnixNano()),
+		"severityNumber": otlpSeverity(r.Level),
+		"severityText":   r.Level.String(),
+		"body":           map[string]any{"stringValue": r.Message},
+	}
+	if len(attrs) > 0 {
+		record["attributes"] = attrs
+	}
+
+	h.batch.mu.Lock()
+	defer h.batch.mu.Unlock()
+	h.batch.pending = append(h.batch.pending, record)
+	if len(h.batch.pending) >= otlpMaxBatch {
+		return h.exportLocked()
+	}
+	if h.batch.timer == nil {
+		h.batch.timer = time.AfterFunc(otlpFlushInterval, func() {
+			h.batch.mu.Lock()
+			defer h.batch.mu.Unlock()
+			h.exportLocked()
+		})
+	}
+	return nil
+}
+
+// WithAttrs implements slog.Handler.
+func (h *OTLPHandler) WithAttrs(attrs []slog.Attr) slog.Handler {
+	clone := *h
+	clone.attrs = append(append([]slog.Attr{}, h.attrs...), qualifyAll(h.groups, attrs)...)
+	return &clone
+}
+
+// WithGroup implements slog.Handler.
+func (h *OTLPHandler) WithGroup(name string) slog.Handler {
+	if name == "" {
+		return h
+	}
+	clone := *h
+	clone.groups = append(append([]string{}, h.groups...), name)
+	return &clone
+}
+
+// Flush exports any buffered records immediately.
+func (h *OTLPHandler) Flush(ctx context.Context) error {
+	h.batch.mu.Lock()
+	defer h.batch.mu.Unlock()
+	return h.exportLocked()
+}
+
+// exportLocked POSTs the pending batch as an ExportLogsServiceRequest. The
+// caller must hold h.batch.mu.
+func (h *OTLPHandler) exportLocked() error {
+	if h.batch.timer != nil {
+		h.batch.timer.Stop()
+		h.batch.timer = nil
+	}
+	if len(h.batch.pending) == 0 {
+		return nil
+	}
+	batch := h.batch.pending
+	h.batch.pending = nil
+	body := map[string]any{
+		"resourceLogs": []map[string]any{{
+			"scopeLogs": []map[string]any{{
+				"scope":      map[string]any{"name": "github.com/lyuangg/yuango/pkg/logging"},
+				"logRecords": batch,
+			}},
+		}},
+	}
+	payload, err := json.Marshal(body)
+	if err != nil {
+		return err
+	}
+	req, err := http.NewRequest(http.MethodPost, h.endpoint+"/v1/logs", bytes.NewReader(payload))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", "application/json")
+	for k, v := range h.headers {
+		req.Header.Set(k, v)
+	}
+	resp, err := h.client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode >= 300 {
+		return fmt.Errorf("logging: otlp export failed: %s", resp.Status)
+	}
+	return nil
+}
+
+// otlpAttr converts a into an OTLP KeyValue.
+func otlpAttr(a slog.Attr) map[string]any {
+	return map[string]any{"key": a.Key, "value": otlpValue(a.Value)}
+}
+
+// otlpValue converts v into an OTLP AnyValue.
+func otlpValue(v slog.Value) map[string]any {
+	v = v.Resolve()
+	switch v.Kind() {
+	case slog.KindBool:
+		return map[string]any{"boolValue": v.Bool()}
+	case slog.KindInt64:
+		return map[string]any{"intValue": fmt.Sprintf("%d", v.Int64())}
+	case slog.KindUint64:
+		return map[string]any{"intValue": fmt.Sprintf("%d", v.Uint64())}
+	case slog.KindFloat64:
+		return map[string]any{"doubleValue": v.Float64()}
+	case slog.KindGroup:
+		values := make([]map[string]any, 0, len(v.Group()))
+		for _, g := range v.Group() {
+			values = append(values, otlpAttr(g))
+		}
+		return map[string]any{"kvlistValue": map[string]any{"values": values}}
+	default:
+		return map[string]any{"stringValue": v.String()}
+	}
+}
+
+// otlpSeverity maps slog levels onto OTLP severity numbers.
+func otlpSeverity(level slog.Level) int {
+	switch {
+	case level >= LevelError:
+		return 17 // ERROR
+	case level >= LevelWarn:
+		return 13 // WARN
+	case level >= LevelInfo:
+		return 9 // INFO
+	default:
+		return 5 // DEBUG
+	}
+}
@@ -0,0 +1,150 @@
+package logging
+
+import (
+	"context"
+	"io"
+	"log/slog"
+)
+
+// Pipeline makes the record path explicit and composable: context
+// extractors enrich, hooks (filters, samplers, scrubbers, forwarders)
+// mutate or drop, the encoder turns records into bytes, and sinks receive
+// them. It processes the same exported Record that hooks and subscribers
+// see, so middleware written for one entry point works everywhere.
+type Pipeline struct {
+	// Extractors pull attributes out of the context, in order.
+	Extractors []func(ctx context.Context) []slog.Attr
+	// Hooks run after extraction and may rewrite or drop the record.
+	Hooks []Hook
+	// Encoder renders surviving records; it typically wraps one or more
+	// sink writers.
+	Encoder slog.Handler
+}
+
+// NewPipeline builds a pipeline from cfg: the configured format becomes
+// the encoder over w, the configured filters and hooks populate the hook
+// chain, and the globally registered context extractors apply. The
+// encoder construction is shared with NewSlogLogger, so both entry points
+// accept the same configurations.
+func NewPipeline(w io.Writer, cfg Config) (*Pipeline, error) {
+	level, err := ParseLevel(cfg.Level)
+	if err != nil {
+		return nil, err
+	}
+	replace, err := resolveReplaceAttr(cfg)
+	if err != nil {
+		return nil, err
+	}
+	levelVar := new(slog.LevelVar)
+	levelVar.Set(level)
+	encoder, err := newEncoder(w, cfg, levelVar, replace)
+	if err != nil {
+		return nil, err
+	}
+	if cfg.StaticFields {
+		encoder = encoder.WithAttrs(staticAttrs(cfg))
+	}
+	if cfg.Dedup {
+		encoder = NewDedupHandler(encoder, 0)
+	}
+	hooks := cfg.Hooks
+	filter, err := filterHook(cfg)
+	if err != nil {
+		return nil, err
+	}
+	if filter != nil {
+		hooks = append([]Hook{filter}, hooks...)
+	}
+	return &Pipeline{
+		Extractors: []func(ctx context.Context) []slog.Attr{contextAttrs},
+		Hooks:      hooks,
+		Encoder:    encoder,
+	}, nil
+}
+
+// Process runs one record through the stages. It reports nil for records
+// dropped by a hook: dropping is a decision, not a failure.
+func (p *Pipeline) Process(ctx context.Context, rec Record) error {
+	if !p.Encoder.Enabled(ctx, rec.Level) {
+		return nil
+	}
+	for _, extract := range p.Extractors {
+		rec.Attrs = append(rec.Attrs, extract(ctx)...)
+	}
+	rec, keep := runHooks(ctx, p.Hooks, rec)
+	if !keep {
+		metricDropped()
+		return nil
+	}
+	r := slog.NewRecord(rec.Time, rec.Level, rec.Message, rec.PC)
+	r.AddAttrs(rec.Attrs...)
+	metricRecord(rec.Level)
+	if err := p.Encoder.Handle(ctx, r); err != nil {
+		metricWriteError()
+		return err
+	}
+	return nil
+}
+
+// AsHandler adapts the pipeline to slog.Handler, so it can sit behind a
+// *slog.Logger or another handler wrapper.
+func (p *Pipeline) AsHandler() slog.Handler {
+	return &pipelineProcessHandler{p: p}
+}
+
+// Logger wraps the pipeline in the package's Logger interface.
+func (p *Pipeline) Logger() *SlogLogger {
+	return NewSlogLoggerWithHandler(p.AsHandler())
+}
+
+// pipelineProcessHandler funnels slog records through Pipeline.Process.
+type pipelineProcessHandler struct {
+	p     *Pipeline
+	attrs []slog.Attr
+	group []string
+}
+
+// Enabled implements slog.Handler.
+func (h *pipelineProcessHandler) Enabled(ctx context.Context, level slog.Level) bool {
+	return h.p.Encoder.Enabled(ctx, level)
+}
+
+// Handle implements slog.Handler.
+func (h *pipelineProcessHandler) Handle(ctx context.Context, r slog.Record) error {
+	rec := Record{Time: r.Time, Level: r.Level, Message: r.Message, PC: r.PC}
+	rec.Attrs = append(rec.Attrs, h.attrs...)
+	prefix := ""
+	if len(h.group) > 0 {
+		for _, g := range h.group {
+			if prefix != "" {
+				prefix += "."
+			}
+			prefix += g
+		}
+	}
+	r.Attrs(func(a slog.Attr) bool {
+		if prefix != "" {
+			a.Key = prefix + "." + a.Key
+		}
+		rec.Attrs = append(rec.Attrs, a)
+		return true
+	})
+	return h.p.Process(ctx, rec)
+}
+
+// WithAttrs implements slog.Handler.
+func (h *pipelineProcessHandler) WithAttrs(attrs []slog.Attr) slog.Handler {
+	clone := *h
+	clone.attrs = append(append([]slog.Attr{}, h.attrs...), qualifyAll(h.group, attrs)...)
+	return &clone
+}
+
+// WithGroup implements slog.Handler.
+func (h *pipelineProcessHandler) WithGroup(name string) slog.Handler {
+	if name == "" {
+		return h
+	}
+	clone := *h
+	clone.group = append(append([]string{}, h.group...), name)
+	return &clone
+}
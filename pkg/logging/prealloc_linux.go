@@ -0,0 +1,19 @@
+//go:build linux
+
+package logging
+
+import (
+	"os"
+	"syscall"
+)
+
+// fallocKeepSize is FALLOC_FL_KEEP_SIZE: reserve blocks without changing
+// the visible file size, so O_APPEND writes still land at the real end.
+const fallocKeepSize = 0x01
+
+// preallocateFile reserves size bytes for f on filesystems that support
+// fallocate (ext4, xfs). Unsupported filesystems return an error that
+// callers ignore.
+func preallocateFile(f *os.File, size int64) error {
+	return syscall.Fallocate(int(f.Fd()), fallocKeepSize, 0, size)
+}
@@ -0,0 +1,80 @@
+package logging
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"io"
+	"log/slog"
+	"regexp"
+	"sync"
+)
+
+// jsonKeyPattern matches object keys in encoded JSON for colorizing.
+var jsonKeyPattern = regexp.MustCompile(`(?m)^(\s*)("[^"]+"):`)
+
+// prettyJSONState is the encode buffer shared by a PrettyJSONHandler and
+// its WithAttrs/WithGroup clones.
+type prettyJSONState struct {
+	mu  sync.Mutex
+	buf bytes.Buffer
+	w   io.Writer
+}
+
+// PrettyJSONHandler emits each record as indented, optionally colorized
+// JSON for local debugging, while keeping one-record-per-write semantics
+// so it still composes with DailyRotateWriter. Selectable via
+// Config.Format "json-pretty".
+type PrettyJSONHandler struct {
+	inner    slog.Handler
+	state    *prettyJSONState
+	colorize bool
+}
+
+// NewPrettyJSONHandler returns a PrettyJSONHandler writing to w.
+func NewPrettyJSONHandler(w io.Writer, level slog.Leveler, colorize bool) *PrettyJSONHandler {
+	state := &prettyJSONState{w: w}
+	inner := slog.NewJSONHandler(&state.buf, &slog.HandlerOptions{Level: level})
+	return &PrettyJSONHandler{inner: inner, state: state, colorize: colorize}
+}
+
+// Enabled implements slog.Handler.
+func (h *PrettyJSONHandler) Enabled(ctx context.Context, level slog.Level) bool {
+	return h.inner.Enabled(ctx, level)
+}
+
+// Handle implements slog.Handler.
+func (h *PrettyJSONHandler) Handle(ctx context.Context, r slog.Record) error {
+	h.state.mu.Lock()
+	defer h.state.mu.Unlock()
+	h.state.buf.Reset()
+	if err := h.inner.Handle(ctx, r); err != nil {
+		return err
+	}
+	var indented bytes.Buffer
+	if err := json.Indent(&indented, bytes.TrimRight(h.state.buf.Bytes(), "\n"), "", "  "); err != nil {
+		// Fall back to the compact encoding rather than dropping the record.
+		_, werr := h.state.w.Write(h.state.buf.Bytes())
+		return werr
+	}
+	out := indented.Bytes()
+	if h.colorize {
+		out = jsonKeyPattern.ReplaceAll(out, []byte("$1"+ansiCyan+"$2"+ansiReset+":"))
+	}
+	_, err := h.state.w.Write(append(out, '\n'))
+	return err
+}
+
+// WithAttrs implements slog.Handler.
+func (h *PrettyJSONHandler) WithAttrs(attrs []slog.Attr) slog.Handler {
+	clone := *h
+	clone.inner = h.inner.WithAttrs(attrs)
+	return &clone
+}
+
+// WithGroup implements slog.Handler.
+func (h *PrettyJSONHandler) WithGroup(name string) slog.Handler {
+	clone := *h
+	clone.inner = h.inner.WithGroup(name)
+	return &clone
+}
@@ -0,0 +1,76 @@
+package logging
+
+import (
+	"context"
+	"hash/fnv"
+	"log/slog"
+	"sync"
+	"time"
+)
+
+// RateLimitConfig controls the message-keyed rate limiting hook.
+type RateLimitConfig struct {
+	// Burst is how many records per key pass in each interval; 0 means 10.
+	Burst int
+	// Interval is the token refill window; 0 means one second.
+	Interval time.Duration
+	// ByAttrs includes a hash of the attributes in the key, so the same
+	// message with different attributes is limited separately.
+	ByAttrs bool
+}
+
+// rateLimitState tracks one key's bucket.
+type rateLimitState struct {
+	windowStart time.Time
+	used        int
+	suppressed  uint64
+}
+
+// NewRateLimitHook returns a hook that caps identical records (same
+// message and level, optionally same attribute hash) to Burst per
+// Interval. When a key's window closes after suppression, the next passed
+// record carries a "suppressed" attribute with the number of duplicates
+// dropped, so tight retry loops cannot flood the output unnoticed.
+func NewRateLimitHook(cfg RateLimitConfig) Hook {
+	if cfg.Burst == 0 {
+		cfg.Burst = 10
+	}
+	if cfg.Interval == 0 {
+		cfg.Interval = time.Second
+	}
+	var mu sync.Mutex
+	states := map[uint64]*rateLimitState{}
+	return func(ctx context.Context, r Record) (Record, bool) {
+		h := fnv.New64a()
+		h.Write([]byte(r.Message))
+		h.Write([]byte{byte(r.Level)})
+		if cfg.ByAttrs {
+			for _, a := range r.Attrs {
+				h.Write([]byte(a.Key))
+				h.Write([]byte(a.Value.Resolve().String()))
+			}
+		}
+		key := h.Sum64()
+		mu.Lock()
+		defer mu.Unlock()
+		st, ok := states[key]
+		if !ok {
+			st = &rateLimitState{}
+			states[key] = st
+		}
+		if r.Time.Sub(st.windowStart) >= cfg.Interval {
+			st.windowStart = r.Time
+			st.used = 0
+			if st.suppressed > 0 {
+				r.Attrs = append(r.Attrs, slog.Uint64("suppressed", st.suppressed))
+				st.suppressed = 0
+			}
+		}
+		st.used++
+		if st.used <= cfg.Burst {
+			return r, true
+		}
+		st.suppressed++
+		return r, false
+	}
+}
@@ -0,0 +1,39 @@
+package logging
+
+import (
+	"context"
+	"fmt"
+	"runtime"
+)
+
+// RecoverAndLog catches a panic in the calling goroutine, logging it at
+// Error with the panic value, stack and goroutine count. Use it directly
+// in a defer statement:
+//
+//	defer logging.RecoverAndLog(ctx, logger)
+func RecoverAndLog(ctx context.Context, l Logger) {
+	if v := recover(); v != nil {
+		logPanic(ctx, l, v)
+	}
+}
+
+// RecoverAndRepanic logs a panic like RecoverAndLog and then re-panics,
+// for cases where the process should still crash but the panic must not
+// bypass the structured log.
+func RecoverAndRepanic(ctx context.Context, l Logger) {
+	if v := recover(); v != nil {
+		logPanic(ctx, l, v)
+		panic(v)
+	}
+}
+
+// logPanic emits the standardized panic record.
+func logPanic(ctx context.Context, l Logger, v any) {
+	buf := make([]byte, 64*1024)
+	buf = buf[:runtime.Stack(buf, false)]
+	l.WithContext(ctx).Error("panic recovered",
+		"panic", fmt.Sprint(v),
+		"stack", string(buf),
+		"goroutines", runtime.NumGoroutine(),
+	)
+}
@@ -0,0 +1,76 @@
+package logging
+
+import (
+	"fmt"
+	"log/slog"
+	"regexp"
+	"strings"
+)
+
+// defaultRedactMask replaces redacted values and pattern matches.
+const defaultRedactMask = "[REDACTED]"
+
+// Redactor masks sensitive attribute values before they reach any output.
+// Keys listed in the configuration are masked entirely (case-insensitive);
+// string values are additionally run through the configured regular
+// expressions with every match replaced by the mask.
+type Redactor struct {
+	keys     map[string]struct{}
+	patterns []*regexp.Regexp
+	mask     string
+}
+
+// NewRedactor compiles a redactor from key names and regex patterns.
+func NewRedactor(keys []string, patterns []string, mask string) (*Redactor, error) {
+	if mask == "" {
+		mask = defaultRedactMask
+	}
+	r := &Redactor{keys: make(map[string]struct{}, len(keys)), mask: mask}
+	for _, k := range keys {
+		r.keys[strings.ToLower(k)] = struct{}{}
+	}
+	for _, p := range patterns {
+		re, err := regexp.Compile(p)
+		if err != nil {
+			return nil, fmt.Errorf("logging: bad redact pattern %q: %w", p, err)
+		}
+		r.patterns = append(r.patterns, re)
+	}
+	return r, nil
+}
+
+// ReplaceAttr is the hook form of the redactor, chainable with the other
+// ReplaceAttr stages.
+func (r *Redactor) ReplaceAttr(groups []string, a slog.Attr) slog.Attr {
+	if _, ok := r.keys[strings.ToLower(a.Key)]; ok {
+		a.Value = slog.StringValue(r.mask)
+		return a
+	}
+	if len(r.patterns) > 0 {
+		v := a.Value.Resolve()
+		if v.Kind() == slog.KindString {
+			s := v.String()
+			masked := s
+			for _, re := range r.patterns {
+				masked = re.ReplaceAllString(masked, r.mask)
+			}
+			if masked != s {
+				a.Value = slog.StringValue(masked)
+			}
+		}
+	}
+	return a
+}
+
+// redactReplaceAttr builds the redaction hook from cfg; it returns nil
+// when no keys or patterns are configured.
+func redactReplaceAttr(cfg Config) (func(groups []string, a slog.Attr) slog.Attr, error) {
+	if len(cfg.RedactKeys) == 0 && len(cfg.RedactPatterns) == 0 {
+		return nil, nil
+	}
+	r, err := NewRedactor(cfg.RedactKeys, cfg.RedactPatterns, cfg.RedactMask)
+	if err != nil {
+		return nil, err
+	}
+	return r.ReplaceAttr, nil
+}
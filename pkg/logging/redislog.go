@@ -0,0 +1,109 @@
+package logging
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"sync/atomic"
+	"time"
+)
+
+// RedisLogger implements the Printf-style interface go-redis accepts via
+// redis.SetLogger, routing the client's internal messages through l:
+//
+//	redis.SetLogger(logging.NewRedisLogger(logger))
+type RedisLogger struct {
+	l Logger
+}
+
+// NewRedisLogger wraps l for redis.SetLogger.
+func NewRedisLogger(l Logger) *RedisLogger {
+	return &RedisLogger{l: l}
+}
+
+// Printf implements go-redis's internal Logging interface.
+func (r *RedisLogger) Printf(ctx context.Context, format string, v ...any) {
+	r.l.WithContext(ctx).Warn(fmt.Sprintf(format, v...))
+}
+
+// RedisCommandConfig tunes command logging.
+type RedisCommandConfig struct {
+	// Level is the level successful commands log at; errors always log at
+	// LevelError. The zero value logs at LevelDebug.
+	Level Level
+	// MaxValueLen truncates each argument's rendering; 0 means 64.
+	MaxValueLen int
+	// SampleEvery logs only one in N successful commands, for hot paths;
+	// 0 and 1 log all of them. Errors are never sampled away.
+	SampleEvery int
+}
+
+// RedisCommandLogger logs Redis commands with latency, errors and
+// truncated arguments. Wire it from the application's redis.Hook:
+//
+//	func (h hook) ProcessHook(next redis.ProcessHook) redis.ProcessHook {
+//		return func(ctx context.Context, cmd redis.Cmder) error {
+//			start := time.Now()
+//			err := next(ctx, cmd)
+//			cmdLog.LogCommand(ctx, cmd.Name(), cmd.Args(), time.Since(start), err)
+//			return err
+//		}
+//	}
+type RedisCommandLogger struct {
+	l   Logger
+	cfg RedisCommandConfig
+	n   atomic.Uint64
+}
+
+// NewRedisCommandLogger returns a command logger writing through l.
+func NewRedisCommandLogger(l Logger, cfg RedisCommandConfig) *RedisCommandLogger {
+	if cfg.Level == 0 {
+		cfg.Level = LevelDebug
+	}
+	if cfg.MaxValueLen == 0 {
+		cfg.MaxValueLen = 64
+	}
+	return &RedisCommandLogger{l: l, cfg: cfg}
+}
+
+// LogCommand records one executed command.
+func (c *RedisCommandLogger) LogCommand(ctx context.Context, name string, args []any, d time.Duration, err error) {
+	if err == nil && c.cfg.SampleEvery > 1 {
+		if c.n.Add(1)%uint64(c.cfg.SampleEvery) != 0 {
+			return
+		}
+	}
+	l := c.l.WithContext(ctx)
+	kv := []any{
+		"cmd", name,
+		"args", c.renderArgs(args),
+		"duration", d,
+	}
+	if err != nil {
+		kv = append(kv, Err(err))
+		l.Error("redis command failed", kv...)
+		return
+	}
+	switch {
+	case c.cfg.Level >= LevelWarn:
+		l.Warn("redis command", kv...)
+	case c.cfg.Level >= LevelInfo:
+		l.Info("redis command", kv...)
+	default:
+		l.Debug("redis command", kv...)
+	}
+}
+
+// renderArgs renders command arguments with per-value truncation so large
+// payloads do not blow up the log.
+func (c *RedisCommandLogger) renderArgs(args []any) string {
+	parts := make([]string, 0, len(args))
+	for _, a := range args {
+		s := fmt.Sprint(a)
+		if len(s) > c.cfg.MaxValueLen {
+			s = s[:c.cfg.MaxValueLen] + "..."
+		}
+		parts = append(parts, s)
+	}
+	return strings.Join(parts, " ")
+}
@@ -0,0 +1,55 @@
+package logging
+
+import (
+	"os"
+	"sync"
+)
+
+// registry caches named component loggers built from a shared Config.
+var registry = struct {
+	sync.Mutex
+	cfg Config
+	m   map[string]Logger
+}{m: map[string]Logger{}}
+
+// Configure sets the Config used to build named loggers returned by Get.
+// Loggers already handed out keep their old configuration; the cache is
+// cleared so later Get calls pick up the new one.
+func Configure(cfg Config) {
+	registry.Lock()
+	defer registry.Unlock()
+	registry.cfg = cfg
+	registry.m = map[string]Logger{}
+}
+
+// Register installs l as the named logger, overriding whatever Get would
+// have built for that name.
+func Register(name string, l Logger) {
+	registry.Lock()
+	defer registry.Unlock()
+	registry.m[name] = l
+}
+
+// Get returns the named component logger, creating and caching it on
+// first use. The logger carries its name as a "logger" attribute, so
+// libraries can call logging.Get("http") or logging.Get("db") without
+// having an instance plumbed through every constructor. If the configured
+// logger cannot be built, Get falls back to a text logger on stderr
+// rather than failing.
+func Get(name string) Logger {
+	registry.Lock()
+	defer registry.Unlock()
+	if l, ok := registry.m[name]; ok {
+		return l
+	}
+	base, err := NewFromConfig(registry.cfg)
+	var l Logger
+	if err != nil {
+		l, _ = NewSlogLogger(os.Stderr, Config{})
+	} else {
+		l = base
+	}
+	l = l.With("logger", name)
+	registry.m[name] = l
+	return l
+}
@@ -0,0 +1,104 @@
+package logging
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"log/slog"
+	"os"
+	"sort"
+	"sync"
+	"time"
+)
+
+// recordedEntry is the serialized form of one captured record.
+type recordedEntry struct {
+	Time  time.Time      `json:"time"`
+	Level string         `json:"level"`
+	Msg   string         `json:"msg"`
+	Attrs map[string]any `json:"attrs,omitempty"`
+}
+
+// Recorder serializes records to a writer as JSON lines, so the traffic
+// of a test run can be replayed later against a changed pipeline. Attach
+// it as a subscriber:
+//
+//	rec := logging.NewRecorder(f)
+//	defer logger.Subscribe(rec.Subscriber())()
+type Recorder struct {
+	mu sync.Mutex
+	w  io.Writer
+}
+
+// NewRecorder returns a recorder writing to w.
+func NewRecorder(w io.Writer) *Recorder {
+	return &Recorder{w: w}
+}
+
+// Subscriber returns the capture function for SlogLogger.Subscribe.
+func (rec *Recorder) Subscriber() Subscriber {
+	return func(r Record) {
+		entry := recordedEntry{Time: r.Time, Level: r.Level.String(), Msg: r.Message}
+		if len(r.Attrs) > 0 {
+			entry.Attrs = make(map[string]any, len(r.Attrs))
+			for _, a := range r.Attrs {
+				entry.Attrs[a.Key] = a.Value.Resolve().Any()
+			}
+		}
+		line, err := json.Marshal(entry)
+		if err != nil {
+			return
+		}
+		rec.mu.Lock()
+		defer rec.mu.Unlock()
+		rec.w.Write(append(line, '\n'))
+	}
+}
+
+// ReplayFile feeds a capture file back through h with the original
+// timestamps, so formatter and sink changes can be validated against real
+// recorded traffic.
+func ReplayFile(path string, h slog.Handler) error {
+	f, err := os.Open(path)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+	return Replay(f, h)
+}
+
+// Replay feeds JSON-line captures from r through h.
+func Replay(r io.Reader, h slog.Handler) error {
+	scanner := bufio.NewScanner(r)
+	scanner.Buffer(make([]byte, 64*1024), 1024*1024)
+	lineNo := 0
+	for scanner.Scan() {
+		lineNo++
+		if len(scanner.Bytes()) == 0 {
+			continue
+		}
+		var entry recordedEntry
+		if err := json.Unmarshal(scanner.Bytes(), &entry); err != nil {
+			return fmt.Errorf("logging: replay line %d: %w", lineNo, err)
+		}
+		level, err := ParseLevel(entry.Level)
+		if err != nil {
+			level = LevelInfo
+		}
+		rec := slog.NewRecord(entry.Time, level, entry.Msg, 0)
+		keys := make([]string, 0, len(entry.Attrs))
+		for k := range entry.Attrs {
+			keys = append(keys, k)
+		}
+		sort.Strings(keys)
+		for _, k := range keys {
+			rec.AddAttrs(slog.Any(k, entry.Attrs[k]))
+		}
+		if err := h.Handle(context.Background(), rec); err != nil {
+			return fmt.Errorf("logging: replay line %d: %w", lineNo, err)
+		}
+	}
+	return scanner.Err()
+}
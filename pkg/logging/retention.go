@@ -0,0 +1,99 @@
+package logging
+
+import (
+	"compress/gzip"
+	"io"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"sort"
+	"time"
+)
+
+// compressedSuffix is appended to rotated files compressed by retention.
+const compressedSuffix = ".gz"
+
+// RetentionPolicy is a tiered retention scheme for rotated files: the most
+// recent KeepUncompressed files stay as-is for quick grepping, the next
+// KeepCompressed are gzip-compressed in place, and anything older is
+// deleted.
+type RetentionPolicy struct {
+	// KeepUncompressed is how many of the newest rotated files are left
+	// uncompressed.
+	KeepUncompressed int
+	// KeepCompressed is how many files beyond that are kept compressed.
+	KeepCompressed int
+}
+
+// WithRetention applies a tiered retention policy after each rotation. It
+// supersedes WithMaxDays when both are configured.
+func WithRetention(p RetentionPolicy) RotateOption {
+	return func(w *DailyRotateWriter) { w.retention = &p }
+}
+
+// applyRetention enforces the tiered policy. The caller must hold w.mu
+// (and the cross-process lock when enabled).
+func (w *DailyRotateWriter) applyRetention() {
+	type datedFile struct {
+		name string
+		date time.Time
+	}
+	var files []datedFile
+	filepath.WalkDir(w.dir, func(name string, d fs.DirEntry, err error) error {
+		if err != nil || d.IsDir() || name == w.curName {
+			return nil
+		}
+		if t, ok := w.parseFileDate(name); ok {
+			files = append(files, datedFile{name, t})
+		}
+		return nil
+	})
+	sort.Slice(files, func(i, j int) bool { return files[i].date.After(files[j].date) })
+
+	for i, f := range files {
+		switch {
+		case i < w.retention.KeepUncompressed:
+			// Newest tier: leave as-is.
+		case i < w.retention.KeepUncompressed+w.retention.KeepCompressed:
+			if filepath.Ext(f.name) != compressedSuffix {
+				compressFile(f.name)
+			}
+		default:
+			if os.Remove(f.name) == nil {
+				for _, fn := range w.onCleanup {
+					go fn(f.name)
+				}
+			}
+		}
+	}
+}
+
+// compressFile gzips name into name+".gz" and removes the original. The
+// original is kept if compression fails.
+func compressFile(name string) error {
+	src, err := os.Open(name)
+	if err != nil {
+		return err
+	}
+	defer src.Close()
+	dst, err := os.Create(name + compressedSuffix)
+	if err != nil {
+		return err
+	}
+	zw := gzip.NewWriter(dst)
+	if _, err := io.Copy(zw, src); err != nil {
+		zw.Close()
+		dst.Close()
+		os.Remove(name + compressedSuffix)
+		return err
+	}
+	if err := zw.Close(); err != nil {
+		dst.Close()
+		os.Remove(name + compressedSuffix)
+		return err
+	}
+	if err := dst.Close(); err != nil {
+		return err
+	}
+	return os.Remove(name)
+}
@@ -0,0 +1,76 @@
+package logging
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func TestRetentionTiers(t *testing.T) {
+	dir := t.TempDir()
+	// Five rotated days, oldest first.
+	dates := []string{"2024-01-01", "2024-01-02", "2024-01-03", "2024-01-04", "2024-01-05"}
+	for _, d := range dates {
+		name := filepath.Join(dir, fmt.Sprintf("app-%s.log", d))
+		if err := os.WriteFile(name, []byte(d+"\n"), 0o644); err != nil {
+			t.Fatal(err)
+		}
+	}
+	w, err := NewDailyRotateWriter(filepath.Join(dir, "app.log"),
+		WithRetention(RetentionPolicy{KeepUncompressed: 1, KeepCompressed: 2}))
+	if err != nil {
+		t.Fatalf("NewDailyRotateWriter: %v", err)
+	}
+	defer w.Close()
+
+	exists := func(name string) bool {
+		_, err := os.Stat(filepath.Join(dir, name))
+		return err == nil
+	}
+	// Newest rotated file stays plain (the current day's file is always
+	// exempt), the next two are compressed, the rest are deleted.
+	if !exists("app-2024-01-05.log") || exists("app-2024-01-05.log.gz") {
+		t.Error("newest rotated file should stay uncompressed")
+	}
+	for _, d := range []string{"2024-01-04", "2024-01-03"} {
+		if exists("app-" + d + ".log") {
+			t.Errorf("app-%s.log should have been compressed away", d)
+		}
+		if !exists("app-" + d + ".log.gz") {
+			t.Errorf("app-%s.log.gz missing from compressed tier", d)
+		}
+	}
+	for _, d := range []string{"2024-01-02", "2024-01-01"} {
+		if exists("app-"+d+".log") || exists("app-"+d+".log.gz") {
+			t.Errorf("app-%s should have been deleted", d)
+		}
+	}
+}
+
+func TestRetentionKeepsAlreadyCompressed(t *testing.T) {
+	dir := t.TempDir()
+	plain := filepath.Join(dir, "app-2024-01-02.log")
+	compressed := filepath.Join(dir, "app-2024-01-01.log.gz")
+	for _, name := range []string{plain, compressed} {
+		if err := os.WriteFile(name, []byte("x\n"), 0o644); err != nil {
+			t.Fatal(err)
+		}
+	}
+	w, err := NewDailyRotateWriter(filepath.Join(dir, "app.log"),
+		WithRetention(RetentionPolicy{KeepUncompressed: 1, KeepCompressed: 1}))
+	if err != nil {
+		t.Fatalf("NewDailyRotateWriter: %v", err)
+	}
+	defer w.Close()
+
+	if _, err := os.Stat(plain); err != nil {
+		t.Error("newest file should stay uncompressed")
+	}
+	if _, err := os.Stat(compressed); err != nil {
+		t.Error("already-compressed file should not be touched")
+	}
+	if _, err := os.Stat(compressed + ".gz"); err == nil {
+		t.Error("compressed file must not be re-compressed")
+	}
+}
@@ -0,0 +1,131 @@
+package logging
+
+import (
+	"encoding/json"
+	"net/http"
+	"strconv"
+	"sync"
+)
+
+// defaultRingCapacity is the record count kept when unset.
+const defaultRingCapacity = 1000
+
+// RingBuffer keeps the last N records in memory, so recent logs stay
+// inspectable even when file or remote sinks are down. Attach it as a
+// subscriber and expose the dump endpoint:
+//
+//	ring := logging.NewRingBuffer(1000)
+//	defer logger.Subscribe(ring.Subscriber())()
+//	mux.Handle("/debug/logs", ring.Handler())
+type RingBuffer struct {
+	mu      sync.Mutex
+	records []Record
+	next    int
+	full    bool
+}
+
+// NewRingBuffer returns a buffer holding the last capacity records;
+// capacity <= 0 means 1000.
+func NewRingBuffer(capacity int) *RingBuffer {
+	if capacity <= 0 {
+		capacity = defaultRingCapacity
+	}
+	return &RingBuffer{records: make([]Record, capacity)}
+}
+
+// Subscriber returns the capture function for SlogLogger.Subscribe.
+func (b *RingBuffer) Subscriber() Subscriber {
+	return func(r Record) {
+		b.mu.Lock()
+		defer b.mu.Unlock()
+		b.records[b.next] = r
+		b.next++
+		if b.next == len(b.records) {
+			b.next = 0
+			b.full = true
+		}
+	}
+}
+
+// Records returns the buffered records, oldest first.
+func (b *RingBuffer) Records() []Record {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	if !b.full {
+		return append([]Record{}, b.records[:b.next]...)
+	}
+	out := make([]Record, 0, len(b.records))
+	out = append(out, b.records[b.next:]...)
+	out = append(out, b.records[:b.next]...)
+	return out
+}
+
+// Handler returns an http.Handler dumping the buffer as a JSON array,
+// oldest first. Query parameters filter the dump: "level" keeps records
+// at or above the given level, "limit" keeps only the newest N, and any
+// other parameter is matched against the attribute of that name, e.g.
+// "?level=warn&component=db&limit=50".
+func (b *RingBuffer) Handler() http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		records := b.Records()
+		query := r.URL.Query()
+		minLevel := Level(-1 << 30)
+		if s := query.Get("level"); s != "" {
+			level, err := ParseLevel(s)
+			if err != nil {
+				http.Error(w, err.Error(), http.StatusBadRequest)
+				return
+			}
+			minLevel = level
+		}
+		limit := 0
+		if s := query.Get("limit"); s != "" {
+			n, err := strconv.Atoi(s)
+			if err != nil || n < 0 {
+				http.Error(w, "bad limit", http.StatusBadRequest)
+				return
+			}
+			limit = n
+		}
+		entries := make([]recordedEntry, 0, len(records))
+		for _, rec := range records {
+			if rec.Level < minLevel || !ringAttrsMatch(query, rec) {
+				continue
+			}
+			entry := recordedEntry{Time: rec.Time, Level: rec.Level.String(), Msg: rec.Message}
+			if len(rec.Attrs) > 0 {
+				entry.Attrs = make(map[string]any, len(rec.Attrs))
+				for _, a := range rec.Attrs {
+					entry.Attrs[a.Key] = a.Value.Resolve().Any()
+				}
+			}
+			entries = append(entries, entry)
+		}
+		if limit > 0 && len(entries) > limit {
+			entries = entries[len(entries)-limit:]
+		}
+		w.Header().Set("Content-Type", "application/json")
+		json.NewEncoder(w).Encode(entries)
+	})
+}
+
+// ringAttrsMatch reports whether rec carries every attribute filter in
+// query, skipping the reserved "level" and "limit" parameters.
+func ringAttrsMatch(query map[string][]string, rec Record) bool {
+	for key, values := range query {
+		if key == "level" || key == "limit" || len(values) == 0 {
+			continue
+		}
+		found := false
+		for _, a := range rec.Attrs {
+			if a.Key == key && a.Value.Resolve().String() == values[0] {
+				found = true
+				break
+			}
+		}
+		if !found {
+			return false
+		}
+	}
+	return true
+}
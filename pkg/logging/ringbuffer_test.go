@@ -0,0 +1,96 @@
+package logging
+
+import (
+	"encoding/json"
+	"fmt"
+	"log/slog"
+	"net/http/httptest"
+	"testing"
+	"time"
+)
+
+func ringTestRecord(i int, level Level, component string) Record {
+	return Record{
+		Time:    time.Unix(int64(1700000000+i), 0),
+		Level:   level,
+		Message: fmt.Sprintf("record %d", i),
+		Attrs:   []slog.Attr{slog.String("component", component)},
+	}
+}
+
+func TestRingBufferWraparound(t *testing.T) {
+	ring := NewRingBuffer(4)
+	sub := ring.Subscriber()
+	for i := 0; i < 6; i++ {
+		sub(ringTestRecord(i, LevelInfo, "db"))
+	}
+	records := ring.Records()
+	if len(records) != 4 {
+		t.Fatalf("got %d records, want 4", len(records))
+	}
+	for i, r := range records {
+		if want := fmt.Sprintf("record %d", i+2); r.Message != want {
+			t.Errorf("records[%d] = %q, want %q (oldest first after wrap)", i, r.Message, want)
+		}
+	}
+}
+
+func TestRingBufferPartialFill(t *testing.T) {
+	ring := NewRingBuffer(4)
+	sub := ring.Subscriber()
+	sub(ringTestRecord(0, LevelInfo, "db"))
+	sub(ringTestRecord(1, LevelInfo, "db"))
+	records := ring.Records()
+	if len(records) != 2 {
+		t.Fatalf("got %d records, want 2", len(records))
+	}
+	if records[0].Message != "record 0" || records[1].Message != "record 1" {
+		t.Errorf("unexpected order: %q, %q", records[0].Message, records[1].Message)
+	}
+}
+
+func TestRingBufferHandlerFilters(t *testing.T) {
+	ring := NewRingBuffer(10)
+	sub := ring.Subscriber()
+	sub(ringTestRecord(0, LevelInfo, "db"))
+	sub(ringTestRecord(1, LevelWarn, "db"))
+	sub(ringTestRecord(2, LevelError, "http"))
+	sub(ringTestRecord(3, LevelError, "db"))
+
+	dump := func(query string) []recordedEntry {
+		t.Helper()
+		rec := httptest.NewRecorder()
+		ring.Handler().ServeHTTP(rec, httptest.NewRequest("GET", "/debug/logs"+query, nil))
+		if rec.Code != 200 {
+			t.Fatalf("status %d for %q: %s", rec.Code, query, rec.Body.String())
+		}
+		var entries []recordedEntry
+		if err := json.Unmarshal(rec.Body.Bytes(), &entries); err != nil {
+			t.Fatalf("bad dump for %q: %v", query, err)
+		}
+		return entries
+	}
+
+	if got := dump(""); len(got) != 4 {
+		t.Errorf("unfiltered dump has %d entries, want 4", len(got))
+	}
+	if got := dump("?level=warn"); len(got) != 3 {
+		t.Errorf("level=warn dump has %d entries, want 3", len(got))
+	}
+	got := dump("?level=warn&component=db")
+	if len(got) != 2 {
+		t.Fatalf("level=warn&component=db dump has %d entries, want 2", len(got))
+	}
+	if got[0].Msg != "record 1" || got[1].Msg != "record 3" {
+		t.Errorf("unexpected filtered entries: %q, %q", got[0].Msg, got[1].Msg)
+	}
+	if got := dump("?limit=1"); len(got) != 1 || got[0].Msg != "record 3" {
+		t.Errorf("limit=1 dump = %+v, want only the newest record", got)
+	}
+
+	rec := httptest.NewRecorder()
+	ring.Handler().ServeHTTP(rec, httptest.NewRequest("GET", "/debug/logs?level=nope", nil))
+	if rec.Code != 400 {
+		t.Errorf("bad level query returned status %d, want 400", rec.Code)
+	}
+}
@@ -0,0 +1,560 @@
+package logging
+
+import (
+	"fmt"
+	"io/fs"
+	"os"
+	"os/signal"
+	"path/filepath"
+	"strings"
+	"sync"
+	"syscall"
+	"time"
+)
+
+// defaultDateFormat is the date suffix appended to rotated file names when
+// no custom format is configured.
+const defaultDateFormat = "2006-01-02"
+
+// rotateCheckInterval is how often the background goroutine checks whether
+// the day has changed.
+const rotateCheckInterval = time.Minute
+
+// DailyRotateWriter is an io.WriteCloser that writes to a dated file and
+// switches to a new file when the day changes. A base path of
+// "/var/log/app.log" produces files like "/var/log/app-2024-05-01.log".
+type DailyRotateWriter struct {
+	base          string // configured path, e.g. /var/log/app.log
+	dir           string
+	prefix        string // file name without extension
+	ext           string
+	maxDays       int
+	symlink       bool
+	dateFormat    string
+	useUTC        bool
+	rotateAt      string        // "HH:MM" wall-clock boundary, "" for midnight
+	dayOffset     time.Duration // parsed from rotateAt
+	restartMarker bool
+	procLock      bool
+	retention     *RetentionPolicy
+	preallocate   int64
+	onError       func(error)
+	durability    Durability
+	syncInterval  time.Duration
+	optErr        error // deferred option failure, checked at construction
+
+	mu         sync.Mutex
+	file       *os.File
+	curDate    string
+	curName    string
+	nextRotate time.Time // logical-day boundary after which writes rotate
+	onRotate   []func(oldPath, newPath string)
+	onCleanup  []func(removedPath string)
+	events     chan RotationEvent
+
+	degraded   DegradedPolicy
+	degradedAt time.Time
+	lastRetry  time.Time
+	ring       [][]byte
+
+	done chan struct{}
+}
+
+// RotateOption configures a DailyRotateWriter.
+type RotateOption func(*DailyRotateWriter)
+
+// WithMaxDays sets how many days of rotated files are kept. Files older
+// than n days are removed after each rotation; n <= 0 keeps everything.
+func WithMaxDays(n int) RotateOption {
+	return func(w *DailyRotateWriter) { w.maxDays = n }
+}
+
+// WithSymlink keeps the base path as a symlink pointing at the current
+// dated file, so "tail -F app.log" and external shippers can follow a
+// stable name. Symlink errors (e.g. unsupported filesystems) are ignored
+// and logging continues against the dated file.
+func WithSymlink(enabled bool) RotateOption {
+	return func(w *DailyRotateWriter) { w.symlink = enabled }
+}
+
+// WithDateFormat sets the time layout used for the date suffix in rotated
+// file names, e.g. "20060102" or "2006/01/02" (the latter creates
+// per-month subdirectories). The default is "2006-01-02".
+func WithDateFormat(layout string) RotateOption {
+	return func(w *DailyRotateWriter) {
+		if layout != "" {
+			w.dateFormat = layout
+		}
+	}
+}
+
+// WithUTC computes the rotation boundary and the filename date in UTC
+// instead of the server's local timezone, so mixed-timezone fleets roll at
+// the same instant with the same names.
+func WithUTC(enabled bool) RotateOption {
+	return func(w *DailyRotateWriter) { w.useUTC = enabled }
+}
+
+// WithRotateAt moves the daily rotation boundary from midnight to the
+// given "HH:MM" wall-clock time, e.g. "04:00" to roll after nightly batch
+// jobs. The date stamped into file names is the date the logical day
+// started on.
+func WithRotateAt(hhmm string) RotateOption {
+	return func(w *DailyRotateWriter) { w.rotateAt = hhmm }
+}
+
+// WithErrorHandler registers fn to receive errors from background
+// rotation, which would otherwise be discarded silently. Applications can
+// surface persistent rotation failures through metrics or alerts.
+func WithErrorHandler(fn func(error)) RotateOption {
+	return func(w *DailyRotateWriter) { w.onError = fn }
+}
+
+// reportError delivers err to the registered error handler, if any.
+func (w *DailyRotateWriter) reportError(err error) {
+	if w.onError != nil && err != nil {
+		w.onError(err)
+	}
+}
+
+// WithPreallocate reserves size bytes for each new day's file at rotation
+// time (fallocate on Linux), reducing fragmentation and the chance of
+// mid-day ENOSPC surprises. Filesystems without support are ignored.
+func WithPreallocate(size int64) RotateOption {
+	return func(w *DailyRotateWriter) { w.preallocate = size }
+}
+
+// WithProcessLock coordinates rotation and cleanup across several worker
+// processes writing to the same base path, using an advisory flock on
+// "<base>.lock". Appending writes are already safe via O_APPEND; the lock
+// prevents concurrent forced rotations from clobbering each other and
+// cleanup from double-deleting files.
+func WithProcessLock(enabled bool) RotateOption {
+	return func(w *DailyRotateWriter) { w.procLock = enabled }
+}
+
+// lockShared runs fn under the cross-process lock when enabled. The caller
+// must already hold w.mu.
+func (w *DailyRotateWriter) lockShared(fn func()) {
+	if w.procLock {
+		if release := acquireFileLock(w.base + ".lock"); release != nil {
+			defer release()
+		}
+	}
+	fn()
+}
+
+// WithRestartMarker writes a marker line into the day file when the
+// writer starts up and finds an existing, non-empty file for the current
+// day, making process restarts visible in the log stream.
+func WithRestartMarker(enabled bool) RotateOption {
+	return func(w *DailyRotateWriter) { w.restartMarker = enabled }
+}
+
+// RotationEvent describes one completed rotation.
+type RotationEvent struct {
+	// OldPath is the file rotated out; empty on the first open.
+	OldPath string
+	// NewPath is the newly opened file.
+	NewPath string
+	// Time is when the rotation happened.
+	Time time.Time
+}
+
+// Events returns a channel that receives a RotationEvent after each
+// rotation, so in-process sidecars (uploaders, indexers) can react without
+// polling the directory. The channel is buffered; events are dropped when
+// the consumer falls behind. It is closed when the writer is closed.
+func (w *DailyRotateWriter) Events() <-chan RotationEvent {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	if w.events == nil {
+		w.events = make(chan RotationEvent, 16)
+	}
+	return w.events
+}
+
+// OnRotate registers fn to be called after each rotation with the path of
+// the file rotated out (empty on the first open) and the newly opened
+// file. Callbacks run on their own goroutine so they may compress, upload
+// or record metrics without blocking writes.
+func (w *DailyRotateWriter) OnRotate(fn func(oldPath, newPath string)) {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	w.onRotate = append(w.onRotate, fn)
+}
+
+// OnCleanup registers fn to be called with the path of each file removed
+// by retention cleanup. Callbacks run on their own goroutine.
+func (w *DailyRotateWriter) OnCleanup(fn func(removedPath string)) {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	w.onCleanup = append(w.onCleanup, fn)
+}
+
+// NewDailyRotateWriter opens a rotating writer for the given base path and
+// starts the background rotation check. When the process restarts mid-day
+// the existing dated file is appended to, retention cleanup runs
+// immediately rather than waiting for the next rotation, and (with
+// WithRestartMarker) a restart marker is written.
+func NewDailyRotateWriter(path string, opts ...RotateOption) (*DailyRotateWriter, error) {
+	ext := filepath.Ext(path)
+	w := &DailyRotateWriter{
+		base:   path,
+		dir:    filepath.Dir(path),
+		prefix: strings.TrimSuffix(filepath.Base(path), ext),
+		ext:    ext,
+		done:   make(chan struct{}),
+
+		dateFormat: defaultDateFormat,
+	}
+	for _, opt := range opts {
+		opt(w)
+	}
+	if w.optErr != nil {
+		return nil, w.optErr
+	}
+	if w.rotateAt != "" {
+		t, err := time.Parse("15:04", w.rotateAt)
+		if err != nil {
+			return nil, fmt.Errorf("logging: invalid rotate-at time %q: %w", w.rotateAt, err)
+		}
+		w.dayOffset = time.Duration(t.Hour())*time.Hour + time.Duration(t.Minute())*time.Minute
+	}
+	if err := os.MkdirAll(w.dir, 0o755); err != nil {
+		return nil, err
+	}
+	now := time.Now()
+	w.mu.Lock()
+	existing := int64(0)
+	if fi, statErr := os.Stat(w.fileName(w.clock(now).Format(w.dateFormat))); statErr == nil {
+		existing = fi.Size()
+	}
+	err := w.openFile(now)
+	if err == nil {
+		w.cleanup(now)
+		if w.restartMarker && existing > 0 {
+			fmt.Fprintf(w.file, "----- process restarted pid=%d time=%s -----\n",
+				os.Getpid(), now.Format(time.RFC3339))
+		}
+	}
+	w.mu.Unlock()
+	if err != nil {
+		return nil, err
+	}
+	go w.autoRotate()
+	if w.durability == DurabilityInterval {
+		go w.autoSync()
+	}
+	return w, nil
+}
+
+// Write appends p to the current day's file.
+func (w *DailyRotateWriter) Write(p []byte) (int, error) {
+	return w.WriteLevel(LevelInfo, p)
+}
+
+// Sync flushes the current file's contents to stable storage.
+func (w *DailyRotateWriter) Sync() error {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	if w.file == nil {
+		return nil
+	}
+	return w.file.Sync()
+}
+
+// Rotate forces an immediate rotation regardless of the date, e.g. before
+// maintenance or after a config change. When the date has not changed, the
+// current file is renamed aside with a numeric suffix
+// ("app-2024-05-01.1.log") and a fresh file is opened; rotation hooks and
+// events fire as usual.
+func (w *DailyRotateWriter) Rotate() error {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	now := time.Now()
+	if w.clock(now).Format(w.dateFormat) != w.curDate {
+		return w.rotateIfNeeded(now)
+	}
+	if w.file != nil {
+		w.file.Close()
+		w.file = nil
+		var renameErr error
+		w.lockShared(func() {
+			renamed := w.nextAsideName(w.curDate)
+			if renameErr = os.Rename(w.curName, renamed); renameErr == nil {
+				w.curName = renamed
+			}
+		})
+		if renameErr != nil {
+			return renameErr
+		}
+	}
+	if err := w.openFile(now); err != nil {
+		return err
+	}
+	w.cleanup(now)
+	return nil
+}
+
+// nextAsideName returns the first unused "prefix-date.N.ext" name for
+// files rotated aside within the same day. The caller must hold w.mu.
+func (w *DailyRotateWriter) nextAsideName(date string) string {
+	for n := 1; ; n++ {
+		name := filepath.Join(w.dir, fmt.Sprintf("%s-%s.%d%s", w.prefix, date, n, w.ext))
+		if _, err := os.Stat(name); os.IsNotExist(err) {
+			return name
+		}
+	}
+}
+
+// Reopen closes and reopens the current file descriptor. It is intended
+// for hosts where system logrotate renames or truncates the active file;
+// after a rename, writes would otherwise keep going to the moved file.
+func (w *DailyRotateWriter) Reopen() error {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	return w.openFile(time.Now())
+}
+
+// ReopenOnSignal installs a signal handler that calls Reopen each time one
+// of the given signals arrives (SIGHUP when none are given), matching the
+// convention logrotate's postrotate scripts expect. The returned stop
+// function uninstalls the handler.
+func (w *DailyRotateWriter) ReopenOnSignal(sigs ...os.Signal) (stop func()) {
+	if len(sigs) == 0 {
+		sigs = []os.Signal{syscall.SIGHUP}
+	}
+	ch := make(chan os.Signal, 1)
+	signal.Notify(ch, sigs...)
+	go func() {
+		for range ch {
+			w.Reopen()
+		}
+	}()
+	return func() {
+		signal.Stop(ch)
+		close(ch)
+	}
+}
+
+// Close stops the background rotation check and closes the current file.
+func (w *DailyRotateWriter) Close() error {
+	close(w.done)
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	if w.events != nil {
+		close(w.events)
+		w.events = nil
+	}
+	if w.file == nil {
+		return nil
+	}
+	err := w.file.Close()
+	w.file = nil
+	return err
+}
+
+// autoRotate is a backstop for idle writers: Write rotates inline on the
+// first record of a new day, but a writer receiving no records would
+// otherwise keep yesterday's file open.
+func (w *DailyRotateWriter) autoRotate() {
+	ticker := time.NewTicker(rotateCheckInterval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-w.done:
+			return
+		case now := <-ticker.C:
+			w.mu.Lock()
+			err := w.rotateIfNeeded(now)
+			w.mu.Unlock()
+			if err != nil {
+				w.reportError(err)
+				w.retryRotate()
+			}
+		}
+	}
+}
+
+// retryRotate retries a failed background rotation with exponential
+// backoff so a transient error (e.g. a permissions blip) does not leave
+// the writer on yesterday's file until the next tick.
+func (w *DailyRotateWriter) retryRotate() {
+	delay := time.Second
+	for attempt := 0; attempt < 5; attempt++ {
+		select {
+		case <-w.done:
+			return
+		case <-time.After(delay):
+		}
+		w.mu.Lock()
+		err := w.rotateIfNeeded(time.Now())
+		w.mu.Unlock()
+		if err == nil {
+			return
+		}
+		w.reportError(err)
+		delay *= 2
+	}
+}
+
+// rotateIfNeeded switches to a new dated file when now's date differs from
+// the open file's. The caller must hold w.mu.
+func (w *DailyRotateWriter) rotateIfNeeded(now time.Time) error {
+	if w.clock(now).Format(w.dateFormat) == w.curDate {
+		return nil
+	}
+	if err := w.openFile(now); err != nil {
+		return err
+	}
+	w.cleanup(now)
+	return nil
+}
+
+// openFile opens the dated file for now, replacing any open file. The
+// caller must hold w.mu.
+func (w *DailyRotateWriter) openFile(now time.Time) error {
+	date := w.clock(now).Format(w.dateFormat)
+	name := w.fileName(date)
+	if err := os.MkdirAll(filepath.Dir(name), 0o755); err != nil {
+		return err
+	}
+	f, err := os.OpenFile(name, os.O_CREATE|os.O_APPEND|os.O_WRONLY, 0o644)
+	if err != nil {
+		return err
+	}
+	if w.preallocate > 0 {
+		preallocateFile(f, w.preallocate)
+	}
+	old := w.curName
+	if w.file != nil {
+		w.file.Close()
+	}
+	w.file = f
+	w.curDate = date
+	w.curName = name
+	lc := w.clock(now)
+	w.nextRotate = time.Date(lc.Year(), lc.Month(), lc.Day(), 0, 0, 0, 0, lc.Location()).AddDate(0, 0, 1)
+	w.updateSymlink(name)
+	if old != name {
+		metricRotation()
+		for _, fn := range w.onRotate {
+			go fn(old, name)
+		}
+		if w.events != nil {
+			select {
+			case w.events <- RotationEvent{OldPath: old, NewPath: name, Time: now}:
+			default:
+			}
+		}
+	}
+	return nil
+}
+
+// clock converts now into the timezone rotation operates in, shifted so
+// that the configured rotate-at time becomes the start of the logical day.
+func (w *DailyRotateWriter) clock(now time.Time) time.Time {
+	if w.useUTC {
+		now = now.UTC()
+	}
+	return now.Add(-w.dayOffset)
+}
+
+func (w *DailyRotateWriter) fileName(date string) string {
+	return filepath.Join(w.dir, fmt.Sprintf("%s-%s%s", w.prefix, date, w.ext))
+}
+
+// updateSymlink points the base path at the current dated file. Failures
+// are ignored so rotation still works on filesystems without symlinks.
+func (w *DailyRotateWriter) updateSymlink(name string) {
+	if !w.symlink {
+		return
+	}
+	target, err := filepath.Rel(filepath.Dir(w.base), name)
+	if err != nil {
+		target = name
+	}
+	tmp := w.base + ".tmp"
+	os.Remove(tmp)
+	if err := os.Symlink(target, tmp); err != nil {
+		return
+	}
+	os.Rename(tmp, w.base)
+}
+
+// cleanup removes rotated files older than maxDays. The caller must hold
+// w.mu.
+func (w *DailyRotateWriter) cleanup(now time.Time) {
+	if w.retention != nil {
+		w.lockShared(w.applyRetention)
+		return
+	}
+	if w.maxDays <= 0 {
+		return
+	}
+	cutoff := w.clock(now).AddDate(0, 0, -w.maxDays)
+	w.lockShared(func() { w.removeOlderThan(cutoff) })
+}
+
+// removeOlderThan deletes rotated files stamped before cutoff. The caller
+// must hold w.mu (and the cross-process lock when enabled).
+func (w *DailyRotateWriter) removeOlderThan(cutoff time.Time) {
+	filepath.WalkDir(w.dir, func(name string, d fs.DirEntry, err error) error {
+		if err != nil || d.IsDir() {
+			return nil
+		}
+		t, ok := w.parseFileDate(name)
+		if ok && t.Before(cutoff) {
+			if os.Remove(name) == nil {
+				for _, fn := range w.onCleanup {
+					go fn(name)
+				}
+			}
+		}
+		return nil
+	})
+}
+
+// parseFileDate extracts the date stamped into a rotated file name, or
+// reports false for files that do not belong to this writer.
+func (w *DailyRotateWriter) parseFileDate(name string) (time.Time, bool) {
+	rel, err := filepath.Rel(w.dir, name)
+	if err != nil {
+		return time.Time{}, false
+	}
+	rel = filepath.ToSlash(rel)
+	for _, suffix := range []string{compressedSuffix, encryptedSuffix} {
+		rel = strings.TrimSuffix(rel, suffix)
+	}
+	if !strings.HasPrefix(rel, w.prefix+"-") || !strings.HasSuffix(rel, w.ext) {
+		return time.Time{}, false
+	}
+	date := strings.TrimSuffix(strings.TrimPrefix(rel, w.prefix+"-"), w.ext)
+	t, err := time.Parse(w.dateFormat, date)
+	if err != nil {
+		// Forced same-day rotations park files aside with a numeric
+		// infix ("app-2024-05-01.1.log"); strip it so those files age
+		// out with the rest.
+		if i := strings.LastIndexByte(date, '.'); i >= 0 && isAllDigits(date[i+1:]) {
+			t, err = time.Parse(w.dateFormat, date[:i])
+		}
+		if err != nil {
+			return time.Time{}, false
+		}
+	}
+	return t, true
+}
+
+// isAllDigits reports whether s is a non-empty run of ASCII digits.
+func isAllDigits(s string) bool {
+	if s == "" {
+		return false
+	}
+	for i := 0; i < len(s); i++ {
+		if s[i] < '0' || s[i] > '9' {
+			return false
+		}
+	}
+	return true
+}
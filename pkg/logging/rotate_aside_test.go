@@ -0,0 +1,57 @@
+package logging
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func TestParseFileDate(t *testing.T) {
+	w, dir := newTestRotateWriter(t)
+	tests := []struct {
+		name string
+		want string
+		ok   bool
+	}{
+		{"app-2024-05-01.log", "2024-05-01", true},
+		{"app-2024-05-01.1.log", "2024-05-01", true}, // aside file from forced Rotate
+		{"app-2024-05-01.12.log", "2024-05-01", true},
+		{"app-2024-05-01.log" + compressedSuffix, "2024-05-01", true},
+		{"app-2024-05-01.log" + encryptedSuffix, "2024-05-01", true},
+		{"other-2024-05-01.log", "", false},
+		{"app-notadate.log", "", false},
+		{"app-2024-05-01.x.log", "", false},
+	}
+	for _, tt := range tests {
+		got, ok := w.parseFileDate(filepath.Join(dir, tt.name))
+		if ok != tt.ok {
+			t.Errorf("parseFileDate(%q) ok = %v, want %v", tt.name, ok, tt.ok)
+			continue
+		}
+		if ok && got.Format(defaultDateFormat) != tt.want {
+			t.Errorf("parseFileDate(%q) = %s, want %s", tt.name, got.Format(defaultDateFormat), tt.want)
+		}
+	}
+}
+
+func TestMaxDaysRemovesAsideFiles(t *testing.T) {
+	dir := t.TempDir()
+	old := filepath.Join(dir, "app-2000-01-01.log")
+	aside := filepath.Join(dir, "app-2000-01-01.1.log")
+	for _, name := range []string{old, aside} {
+		if err := os.WriteFile(name, []byte("x\n"), 0o644); err != nil {
+			t.Fatal(err)
+		}
+	}
+	// Construction runs cleanup immediately.
+	w, err := NewDailyRotateWriter(filepath.Join(dir, "app.log"), WithMaxDays(7))
+	if err != nil {
+		t.Fatalf("NewDailyRotateWriter: %v", err)
+	}
+	defer w.Close()
+	for _, name := range []string{old, aside} {
+		if _, err := os.Stat(name); !os.IsNotExist(err) {
+			t.Errorf("%s survived WithMaxDays cleanup", filepath.Base(name))
+		}
+	}
+}
@@ -0,0 +1,55 @@
+package logging
+
+import (
+	"path/filepath"
+	"testing"
+	"time"
+)
+
+func newTestRotateWriter(t *testing.T, opts ...RotateOption) (*DailyRotateWriter, string) {
+	t.Helper()
+	dir := t.TempDir()
+	w, err := NewDailyRotateWriter(filepath.Join(dir, "app.log"), opts...)
+	if err != nil {
+		t.Fatalf("NewDailyRotateWriter: %v", err)
+	}
+	t.Cleanup(func() { w.Close() })
+	return w, dir
+}
+
+func TestRotateAtBoundaryMath(t *testing.T) {
+	w, _ := newTestRotateWriter(t, WithRotateAt("04:00"))
+	if want := 4 * time.Hour; w.dayOffset != want {
+		t.Fatalf("dayOffset = %v, want %v", w.dayOffset, want)
+	}
+	// Before the boundary the logical day is still yesterday; after it,
+	// today.
+	before := time.Date(2024, 5, 2, 3, 59, 0, 0, time.Local)
+	after := time.Date(2024, 5, 2, 4, 1, 0, 0, time.Local)
+	if got := w.clock(before).Format(w.dateFormat); got != "2024-05-01" {
+		t.Errorf("clock(03:59) date = %q, want 2024-05-01", got)
+	}
+	if got := w.clock(after).Format(w.dateFormat); got != "2024-05-02" {
+		t.Errorf("clock(04:01) date = %q, want 2024-05-02", got)
+	}
+}
+
+func TestRotateAtRejectsBadTime(t *testing.T) {
+	dir := t.TempDir()
+	if _, err := NewDailyRotateWriter(filepath.Join(dir, "app.log"), WithRotateAt("25:99")); err == nil {
+		t.Fatal("NewDailyRotateWriter accepted rotate-at time 25:99")
+	}
+}
+
+func TestNextRotateIsLogicalMidnight(t *testing.T) {
+	w, _ := newTestRotateWriter(t)
+	now := time.Now()
+	if !w.nextRotate.After(now) {
+		t.Fatalf("nextRotate %v is not in the future", w.nextRotate)
+	}
+	lc := w.clock(w.nextRotate.Add(-time.Nanosecond))
+	lcNext := w.clock(w.nextRotate)
+	if lc.Day() == lcNext.Day() {
+		t.Errorf("nextRotate %v does not sit on a logical day boundary", w.nextRotate)
+	}
+}
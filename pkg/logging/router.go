@@ -0,0 +1,158 @@
+package logging
+
+import (
+	"context"
+	"log/slog"
+	"strings"
+)
+
+// LevelRouterHandler sends records at or above a threshold to one handler
+// and everything below it to another, so operators can tail only the
+// error stream:
+//
+//	low := slog.NewTextHandler(os.Stdout, nil)
+//	high := slog.NewTextHandler(os.Stderr, nil)
+//	logger := logging.NewSlogLoggerWithHandler(logging.NewLevelRouterHandler(logging.LevelWarn, low, high))
+//
+// The same split is available declaratively through Config.Outputs by
+// giving the low destination a MaxLevel and the high one a Level.
+type LevelRouterHandler struct {
+	threshold slog.Level
+	low       slog.Handler
+	high      slog.Handler
+}
+
+// NewLevelRouterHandler returns a router sending records at or above
+// threshold to high and the rest to low.
+func NewLevelRouterHandler(threshold slog.Level, low, high slog.Handler) *LevelRouterHandler {
+	return &LevelRouterHandler{threshold: threshold, low: low, high: high}
+}
+
+// route picks the handler for level.
+func (h *LevelRouterHandler) route(level slog.Level) slog.Handler {
+	if level >= h.threshold {
+		return h.high
+	}
+	return h.low
+}
+
+// Enabled implements slog.Handler.
+func (h *LevelRouterHandler) Enabled(ctx context.Context, level slog.Level) bool {
+	return h.route(level).Enabled(ctx, level)
+}
+
+// Handle implements slog.Handler.
+func (h *LevelRouterHandler) Handle(ctx context.Context, r slog.Record) error {
+	return h.route(r.Level).Handle(ctx, r)
+}
+
+// WithAttrs implements slog.Handler.
+func (h *LevelRouterHandler) WithAttrs(attrs []slog.Attr) slog.Handler {
+	return &LevelRouterHandler{threshold: h.threshold, low: h.low.WithAttrs(attrs), high: h.high.WithAttrs(attrs)}
+}
+
+// WithGroup implements slog.Handler.
+func (h *LevelRouterHandler) WithGroup(name string) slog.Handler {
+	if name == "" {
+		return h
+	}
+	return &LevelRouterHandler{threshold: h.threshold, low: h.low.WithGroup(name), high: h.high.WithGroup(name)}
+}
+
+// maxLevelHandler caps a destination at a maximum level, the complement
+// of slog's minimum-level gate, for OutputConfig.MaxLevel.
+type maxLevelHandler struct {
+	inner slog.Handler
+	max   slog.Level
+}
+
+// Enabled implements slog.Handler.
+func (h maxLevelHandler) Enabled(ctx context.Context, level slog.Level) bool {
+	return level <= h.max && h.inner.Enabled(ctx, level)
+}
+
+// Handle implements slog.Handler.
+func (h maxLevelHandler) Handle(ctx context.Context, r slog.Record) error {
+	return h.inner.Handle(ctx, r)
+}
+
+// WithAttrs implements slog.Handler.
+func (h maxLevelHandler) WithAttrs(attrs []slog.Attr) slog.Handler {
+	return maxLevelHandler{inner: h.inner.WithAttrs(attrs), max: h.max}
+}
+
+// WithGroup implements slog.Handler.
+func (h maxLevelHandler) WithGroup(name string) slog.Handler {
+	if name == "" {
+		return h
+	}
+	return maxLevelHandler{inner: h.inner.WithGroup(name), max: h.max}
+}
+
+// matchHandler gates a destination on OutputConfig.Match rules. Matching
+// happens per record at Handle time, over both the handler's accumulated
+// With() attributes and the record's own.
+type matchHandler struct {
+	inner  slog.Handler
+	rules  []compiledFilter
+	attrs  []slog.Attr
+	groups []string
+}
+
+// newMatchHandler compiles rules around inner.
+func newMatchHandler(inner slog.Handler, rules []FilterRule) (slog.Handler, error) {
+	compiled := make([]compiledFilter, 0, len(rules))
+	for _, rule := range rules {
+		f, err := compileFilterRule(rule)
+		if err != nil {
+			return nil, err
+		}
+		compiled = append(compiled, f)
+	}
+	return matchHandler{inner: inner, rules: compiled}, nil
+}
+
+// Enabled implements slog.Handler. Attributes are unknown at this point,
+// so only the inner handler's level gate applies here.
+func (h matchHandler) Enabled(ctx context.Context, level slog.Level) bool {
+	return h.inner.Enabled(ctx, level)
+}
+
+// Handle implements slog.Handler.
+func (h matchHandler) Handle(ctx context.Context, r slog.Record) error {
+	rec := Record{Time: r.Time, Level: r.Level, Message: r.Message, PC: r.PC}
+	rec.Attrs = append(rec.Attrs, h.attrs...)
+	prefix := strings.Join(h.groups, ".")
+	r.Attrs(func(a slog.Attr) bool {
+		if prefix != "" {
+			a.Key = prefix + "." + a.Key
+		}
+		rec.Attrs = append(rec.Attrs, a)
+		return true
+	})
+	for _, f := range h.rules {
+		if f.matches(rec) {
+			return h.inner.Handle(ctx, r)
+		}
+	}
+	return nil
+}
+
+// WithAttrs implements slog.Handler.
+func (h matchHandler) WithAttrs(attrs []slog.Attr) slog.Handler {
+	clone := h
+	clone.attrs = append(append([]slog.Attr{}, h.attrs...), qualifyAll(h.groups, attrs)...)
+	clone.inner = h.inner.WithAttrs(attrs)
+	return clone
+}
+
+// WithGroup implements slog.Handler.
+func (h matchHandler) WithGroup(name string) slog.Handler {
+	if name == "" {
+		return h
+	}
+	clone := h
+	clone.groups = append(append([]string{}, h.groups...), name)
+	clone.inner = h.inner.WithGroup(name)
+	return clone
+}
@@ -0,0 +1,72 @@
+package logging
+
+import (
+	"context"
+	"log/slog"
+	"sync"
+	"time"
+)
+
+// SamplingConfig controls the probabilistic sampling hook.
+type SamplingConfig struct {
+	// Initial is how many records per level pass at full rate in each
+	// one-second window; 0 means 100.
+	Initial int
+	// Thereafter keeps 1 in Thereafter once Initial is exhausted; 0 means
+	// 100. 1 disables thinning.
+	Thereafter int
+	// MaxLevel bounds sampling: records above it (warnings and errors by
+	// default) always pass.
+	MaxLevel Level
+}
+
+// samplerState tracks one level's window.
+type samplerState struct {
+	windowStart time.Time
+	seen        int
+	dropped     uint64
+}
+
+// NewSamplingHook returns a hook that passes the first Initial records of
+// each level per second, then 1 in Thereafter, so debug/info floods do not
+// overwhelm storage. When a new window opens after drops, the first record
+// carries a "sampled" attribute with the number of records sampled away.
+func NewSamplingHook(cfg SamplingConfig) Hook {
+	if cfg.Initial == 0 {
+		cfg.Initial = 100
+	}
+	if cfg.Thereafter == 0 {
+		cfg.Thereafter = 100
+	}
+	if cfg.MaxLevel == 0 {
+		cfg.MaxLevel = LevelInfo
+	}
+	var mu sync.Mutex
+	states := map[Level]*samplerState{}
+	return func(ctx context.Context, r Record) (Record, bool) {
+		if r.Level > cfg.MaxLevel {
+			return r, true
+		}
+		mu.Lock()
+		defer mu.Unlock()
+		st, ok := states[r.Level]
+		if !ok {
+			st = &samplerState{}
+			states[r.Level] = st
+		}
+		if r.Time.Sub(st.windowStart) >= time.Second {
+			st.windowStart = r.Time
+			st.seen = 0
+			if st.dropped > 0 {
+				r.Attrs = append(r.Attrs, slog.Uint64("sampled", st.dropped))
+				st.dropped = 0
+			}
+		}
+		st.seen++
+		if st.seen <= cfg.Initial || (st.seen-cfg.Initial)%cfg.Thereafter == 0 {
+			return r, true
+		}
+		st.dropped++
+		return r, false
+	}
+}
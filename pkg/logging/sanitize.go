@@ -0,0 +1,57 @@
+package logging
+
+import (
+	"log/slog"
+	"strings"
+	"unicode"
+)
+
+// sanitizeString escapes embedded newlines and other control characters so
+// a record always occupies a single line and attacker-controlled input
+// cannot forge extra records.
+func sanitizeString(s string) string {
+	if !strings.ContainsFunc(s, unicode.IsControl) {
+		return s
+	}
+	var b strings.Builder
+	b.Grow(len(s))
+	for _, r := range s {
+		switch r {
+		case '\n':
+			b.WriteString(`\n`)
+		case '\r':
+			b.WriteString(`\r`)
+		case '\t':
+			b.WriteString(`\t`)
+		default:
+			if unicode.IsControl(r) {
+				b.WriteString(`\x`)
+				const hex = "0123456789abcdef"
+				b.WriteByte(hex[byte(r)>>4])
+				b.WriteByte(hex[byte(r)&0xf])
+			} else {
+				b.WriteRune(r)
+			}
+		}
+	}
+	return b.String()
+}
+
+// sanitizeReplaceAttr builds a ReplaceAttr hook escaping control
+// characters in the message and string attribute values. It returns nil
+// unless cfg.Sanitize is set; it is mainly useful with the text format,
+// where slog does not escape values itself.
+func sanitizeReplaceAttr(cfg Config) func(groups []string, a slog.Attr) slog.Attr {
+	if !cfg.Sanitize {
+		return nil
+	}
+	return func(groups []string, a slog.Attr) slog.Attr {
+		v := a.Value.Resolve()
+		if v.Kind() == slog.KindString {
+			if s := v.String(); strings.ContainsFunc(s, unicode.IsControl) {
+				a.Value = slog.StringValue(sanitizeString(s))
+			}
+		}
+		return a
+	}
+}
@@ -0,0 +1,146 @@
+package logging
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"strings"
+	"sync/atomic"
+)
+
+// ScrubRule names one PII pattern: substrings matching Pattern are
+// masked wherever they appear, and values under the listed Keys are
+// masked entirely.
+type ScrubRule struct {
+	// Name identifies the rule in metrics.
+	Name string `json:"name"`
+	// Pattern is a regular expression matched against the message and
+	// string attribute values.
+	Pattern string `json:"pattern"`
+	// Keys are attribute keys (case-insensitive) whose values are always
+	// masked.
+	Keys []string `json:"keys"`
+}
+
+// Built-in scrub rule sets, selectable by name.
+var builtinScrubRules = map[string]ScrubRule{
+	"email": {
+		Name:    "email",
+		Pattern: `[a-zA-Z0-9._%+-]+@[a-zA-Z0-9.-]+\.[a-zA-Z]{2,}`,
+	},
+	"phone": {
+		Name:    "phone",
+		Pattern: `\+?[0-9][0-9 ()-]{7,}[0-9]`,
+	},
+	"credit-card": {
+		Name:    "credit-card",
+		Pattern: `\b(?:\d[ -]?){13,16}\b`,
+	},
+	"national-id": {
+		Name:    "national-id",
+		Pattern: `\b\d{3}-\d{2}-\d{4}\b`,
+	},
+}
+
+// BuiltinScrubRules returns the named built-in rules ("email", "phone",
+// "credit-card", "national-id").
+func BuiltinScrubRules(names ...string) ([]ScrubRule, error) {
+	rules := make([]ScrubRule, 0, len(names))
+	for _, name := range names {
+		rule, ok := builtinScrubRules[name]
+		if !ok {
+			return nil, fmt.Errorf("logging: unknown scrub rule set %q", name)
+		}
+		rules = append(rules, rule)
+	}
+	return rules, nil
+}
+
+// scrubRuleState is one compiled rule plus its fire counter.
+type scrubRuleState struct {
+	name     string
+	redactor *Redactor
+	keys     map[string]struct{}
+	fired    atomic.Uint64
+}
+
+// Scrubber masks PII in messages and attribute values before encoding,
+// and counts how often each rule fires so coverage can be monitored.
+type Scrubber struct {
+	rules []*scrubRuleState
+	mask  string
+}
+
+// NewScrubber compiles the rules; an empty mask means "[REDACTED]".
+func NewScrubber(rules []ScrubRule, mask string) (*Scrubber, error) {
+	if mask == "" {
+		mask = defaultRedactMask
+	}
+	s := &Scrubber{mask: mask}
+	for _, rule := range rules {
+		var patterns []string
+		if rule.Pattern != "" {
+			patterns = []string{rule.Pattern}
+		}
+		red, err := NewRedactor(nil, patterns, mask)
+		if err != nil {
+			return nil, err
+		}
+		st := &scrubRuleState{name: rule.Name, redactor: red, keys: map[string]struct{}{}}
+		for _, k := range rule.Keys {
+			st.keys[strings.ToLower(k)] = struct{}{}
+		}
+		s.rules = append(s.rules, st)
+	}
+	return s, nil
+}
+
+// Counts reports how many times each rule has fired since construction.
+func (s *Scrubber) Counts() map[string]uint64 {
+	out := make(map[string]uint64, len(s.rules))
+	for _, st := range s.rules {
+		out[st.name] = st.fired.Load()
+	}
+	return out
+}
+
+// scrubString applies every rule's pattern to v.
+func (s *Scrubber) scrubString(v string) string {
+	for _, st := range s.rules {
+		masked := st.redactor.ReplaceAttr(nil, slog.String("", v))
+		if got := masked.Value.String(); got != v {
+			st.fired.Add(1)
+			v = got
+		}
+	}
+	return v
+}
+
+// Hook returns the scrubber as a record hook, masking the message and
+// attribute values.
+func (s *Scrubber) Hook() Hook {
+	return func(ctx context.Context, r Record) (Record, bool) {
+		r.Message = s.scrubString(r.Message)
+		for i, a := range r.Attrs {
+			lower := strings.ToLower(a.Key)
+			masked := false
+			for _, st := range s.rules {
+				if _, ok := st.keys[lower]; ok {
+					r.Attrs[i].Value = slog.StringValue(s.mask)
+					st.fired.Add(1)
+					masked = true
+					break
+				}
+			}
+			if masked {
+				continue
+			}
+			if v := a.Value.Resolve(); v.Kind() == slog.KindString {
+				if got := s.scrubString(v.String()); got != v.String() {
+					r.Attrs[i].Value = slog.StringValue(got)
+				}
+			}
+		}
+		return r, true
+	}
+}
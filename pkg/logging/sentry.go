@@ -0,0 +1,185 @@
+package logging
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"log/slog"
+	"net/http"
+	"net/url"
+	"strings"
+	"sync"
+	"time"
+)
+
+// SentryConfig controls the Sentry forwarding hook.
+type SentryConfig struct {
+	// DSN is the project DSN, e.g. "https://key@o0.ingest.sentry.io/123".
+	DSN string
+	// MinLevel is the lowest level forwarded; nil means LevelError, so a
+	// MinLevel of LevelInfo is expressible.
+	MinLevel slog.Leveler
+	// MaxPerMinute caps forwarded events; 0 means 60. Excess records
+	// still reach the normal output, only the Sentry copy is skipped.
+	MaxPerMinute int
+	// Environment and Release tag every event, when set.
+	Environment string
+	Release     string
+}
+
+// SentryHook forwards error records to Sentry over its store API, without
+// a Sentry SDK dependency. Attributes become the event's extra context, a
+// "stack" attribute becomes the exception stacktrace, and forwarding is
+// rate limited so an error storm cannot saturate the project quota.
+// Register the hook and flush on shutdown:
+//
+//	sentry, err := logging.NewSentryHook(logging.SentryConfig{DSN: dsn})
+//	logger.AddHook(sentry.Hook())
+//	defer sentry.Flush(ctx)
+type SentryHook struct {
+	cfg      SentryConfig
+	endpoint string
+	auth     string
+	client   *http.Client
+
+	mu          sync.Mutex
+	windowStart time.Time
+	sent        int
+	wg          sync.WaitGroup
+}
+
+// NewSentryHook parses the DSN and returns the hook.
+func NewSentryHook(cfg SentryConfig) (*SentryHook, error) {
+	u, err := url.Parse(cfg.DSN)
+	if err != nil || u.Host == "" || u.User == nil {
+		return nil, fmt.Errorf("logging: bad sentry dsn %q", cfg.DSN)
+	}
+	project := strings.TrimPrefix(u.Path, "/")
+	if project == "" {
+		return nil, fmt.Errorf("logging: sentry dsn %q has no project", cfg.DSN)
+	}
+	if cfg.MinLevel == nil {
+		cfg.MinLevel = LevelError
+	}
+	if cfg.MaxPerMinute == 0 {
+		cfg.MaxPerMinute = 60
+	}
+	return &SentryHook{
+		cfg:      cfg,
+		endpoint: fmt.Sprintf("%s://%s/api/%s/store/", u.Scheme, u.Host, project),
+		auth:     fmt.Sprintf("Sentry sentry_version=7, sentry_client=yuango-logging/1.0, sentry_key=%s", u.User.Username()),
+		client:   &http.Client{Timeout: 5 * time.Second},
+	}, nil
+}
+
+// Hook returns the forwarding hook; records always pass through to the
+// normal output.
+func (h *SentryHook) Hook() Hook {
+	return func(ctx context.Context, r Record) (Record, bool) {
+		if r.Level >= h.cfg.MinLevel.Level() && h.allow(r.Time) {
+			h.wg.Add(1)
+			go h.send(r)
+		}
+		return r, true
+	}
+}
+
+// allow applies the per-minute cap.
+func (h *SentryHook) allow(now time.Time) bool {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	if now.Sub(h.windowStart) >= time.Minute {
+		h.windowStart = now
+		h.sent = 0
+	}
+	if h.sent >= h.cfg.MaxPerMinute {
+		return false
+	}
+	h.sent++
+	return true
+}
+
+// sentryLevel maps a record level onto Sentry's fixed level names. The
+// store API only knows "fatal", "error", "warning", "info" and "debug";
+// anything else (such as lowercased "warn", or "error+4" from a custom
+// level) is normalized to "error" server-side, so the names must be
+// produced explicitly rather than derived from Level.String().
+func sentryLevel(l Level) string {
+	switch {
+	case l >= LevelError:
+		return "error"
+	case l >= LevelWarn:
+		return "warning"
+	case l >= LevelInfo:
+		return "info"
+	default:
+		return "debug"
+	}
+}
+
+// send posts one event; failures are dropped silently, as a logging
+// backend must never log about itself recursively.
+func (h *SentryHook) send(r Record) {
+	defer h.wg.Done()
+	extra := map[string]any{}
+	var stack string
+	for _, a := range r.Attrs {
+		if a.Key == "stack" {
+			stack = a.Value.Resolve().String()
+			continue
+		}
+		extra[a.Key] = a.Value.Resolve().Any()
+	}
+	event := map[string]any{
+		"message":   r.Message,
+		"level":     sentryLevel(r.Level),
+		"timestamp": r.Time.UTC().Format("2006-01-02T15:04:05"),
+		"platform":  "go",
+		"extra":     extra,
+	}
+	if h.cfg.Environment != "" {
+		event["environment"] = h.cfg.Environment
+	}
+	if h.cfg.Release != "" {
+		event["release"] = h.cfg.Release
+	}
+	if stack != "" {
+		event["exception"] = []map[string]any{{
+			"type":  r.Message,
+			"value": stack,
+		}}
+	}
+	body, err := json.Marshal(event)
+	if err != nil {
+		return
+	}
+	req, err := http.NewRequest(http.MethodPost, h.endpoint, bytes.NewReader(body))
+	if err != nil {
+		return
+	}
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("X-Sentry-Auth", h.auth)
+	resp, err := h.client.Do(req)
+	if err != nil {
+		return
+	}
+	resp.Body.Close()
+}
+
+// Flush waits for in-flight events, bounded by ctx.
+func (h *SentryHook) Flush(ctx context.Context) error {
+	done := make(chan struct{})
+	go func() {
+		h.wg.Wait()
+		close(done)
+	}()
+	select {
+	case <-done:
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
+
+var _ Syncer = (*SentryHook)(nil)
@@ -0,0 +1,576 @@
+package logging
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"log/slog"
+	"os"
+	"runtime"
+	"strings"
+	"sync"
+	"time"
+)
+
+// SlogLogger implements Logger on top of a *slog.Logger.
+type SlogLogger struct {
+	logger     *slog.Logger
+	ctx        context.Context
+	out        io.Writer
+	addSource  bool
+	stackMin   *Level
+	stackDepth int
+	level      *slog.LevelVar
+	ownsOut    bool
+	hooks      []Hook
+	subs       *subscriberSet
+}
+
+var (
+	_ Logger = (*SlogLogger)(nil)
+	_ Syncer = (*SlogLogger)(nil)
+)
+
+// NewSlogLogger builds a SlogLogger writing to w according to cfg's level
+// and format.
+func NewSlogLogger(w io.Writer, cfg Config) (*SlogLogger, error) {
+	level, err := ParseLevel(cfg.Level)
+	if err != nil {
+		return nil, err
+	}
+	replace, err := resolveReplaceAttr(cfg)
+	if err != nil {
+		return nil, err
+	}
+	levelVar := new(slog.LevelVar)
+	levelVar.Set(level)
+	handler, err := newEncoder(w, cfg, levelVar, replace)
+	if err != nil {
+		return nil, err
+	}
+	return assembleLogger(handler, w, cfg, levelVar)
+}
+
+// assembleLogger wraps an encoder in the configured static fields, dedup,
+// filter and hook plumbing. It is shared by NewSlogLogger and the
+// multi-output path.
+func assembleLogger(handler slog.Handler, w io.Writer, cfg Config, levelVar *slog.LevelVar) (*SlogLogger, error) {
+	if cfg.StaticFields {
+		handler = handler.WithAttrs(staticAttrs(cfg))
+	}
+	if cfg.Dedup {
+		handler = NewDedupHandler(handler, 0)
+	}
+	hooks := cfg.Hooks
+	filter, err := filterHook(cfg)
+	if err != nil {
+		return nil, err
+	}
+	if filter != nil {
+		hooks = append([]Hook{filter}, hooks...)
+	}
+	l := &SlogLogger{logger: slog.New(handler), out: w, addSource: cfg.Source, stackDepth: cfg.StackDepth, level: levelVar, hooks: hooks, subs: &subscriberSet{}}
+	if cfg.StackLevel != "" {
+		stackMin, err := ParseLevel(cfg.StackLevel)
+		if err != nil {
+			return nil, err
+		}
+		l.stackMin = &stackMin
+	}
+	return l, nil
+}
+
+// NewSlogLoggerWithHandler wraps a caller-supplied slog.Handler in the
+// package's Logger interface, so applications with a bespoke handler
+// (sampling, tenant routing, exotic formats) keep With/WithContext
+// semantics and the rest of the package's plumbing.
+func NewSlogLoggerWithHandler(h slog.Handler) *SlogLogger {
+	return &SlogLogger{logger: slog.New(h), subs: &subscriberSet{}}
+}
+
+// NewFromConfig resolves cfg.Output into a writer and returns a logger for
+// it. File outputs are opened in append mode; with cfg.Rotate set they are
+// wrapped in a DailyRotateWriter.
+func NewFromConfig(cfg Config) (*SlogLogger, error) {
+	if len(cfg.Outputs) > 0 {
+		return newFanoutFromConfig(cfg)
+	}
+	w, err := openOutput(cfg)
+	if err != nil {
+		return nil, err
+	}
+	l, err := NewSlogLogger(w, cfg)
+	if err != nil {
+		return nil, err
+	}
+	// The writer was opened here, so Close should release it.
+	l.ownsOut = w != os.Stdout && w != os.Stderr
+	return l, nil
+}
+
+// staticAttrs resolves the Config.StaticFields attribute set once, at
+// logger construction.
+func staticAttrs(cfg Config) []slog.Attr {
+	hostname, _ := os.Hostname()
+	app := cfg.App
+	if app == "" {
+		app = processName()
+	}
+	attrs := []slog.Attr{
+		slog.String("hostname", hostname),
+		slog.Int("pid", os.Getpid()),
+		slog.String("app", app),
+	}
+	if cfg.AppVersion != "" {
+		attrs = append(attrs, slog.String("version", cfg.AppVersion))
+	}
+	if cfg.Env != "" {
+		attrs = append(attrs, slog.String("env", cfg.Env))
+	}
+	return attrs
+}
+
+// newEncoder builds the format encoder — the handler that turns records
+// into bytes on w — from cfg. It is shared by NewSlogLogger and
+// NewPipeline.
+func newEncoder(w io.Writer, cfg Config, level slog.Leveler, replace func(groups []string, a slog.Attr) slog.Attr) (slog.Handler, error) {
+	opts := &slog.HandlerOptions{Level: level, AddSource: cfg.Source, ReplaceAttr: replace}
+	if cfg.Output == "journald" {
+		// journald's native protocol couples encoding and transport, so
+		// the output selects the encoder regardless of Format.
+		return NewJournaldHandler(w, level, cfg.SyslogTag), nil
+	}
+	switch cfg.Format {
+	case "", "text":
+		return slog.NewTextHandler(w, opts), nil
+	case "json":
+		return slog.NewJSONHandler(w, opts), nil
+	case "template":
+		if cfg.Template == "" {
+			return nil, fmt.Errorf("logging: template format requires Template")
+		}
+		return NewTemplateHandler(w, level, cfg.Template)
+	case "json-pretty":
+		return NewPrettyJSONHandler(w, level, false), nil
+	case "console", "pretty":
+		return NewConsoleHandler(w, &ConsoleHandlerOptions{Level: level}), nil
+	case "ecs":
+		return NewECSHandler(w, level), nil
+	case "cef":
+		return NewCEFHandler(w, CEFOptions{Level: level}), nil
+	case "syslog", "rfc5424":
+		return NewSyslogHandler(w, SyslogOptions{Level: level, Facility: cfg.SyslogFacility, AppName: cfg.SyslogTag}), nil
+	case "syslog-bsd", "rfc3164":
+		return NewSyslogHandler(w, SyslogOptions{Level: level, Facility: cfg.SyslogFacility, AppName: cfg.SyslogTag, RFC3164: true}), nil
+	case "gelf":
+		return NewGELFHandler(w, level), nil
+	case "binary":
+		return NewBinaryHandler(w, level), nil
+	case "otlp":
+		if cfg.OTLPEndpoint == "" {
+			return nil, fmt.Errorf("logging: otlp format requires OTLPEndpoint")
+		}
+		return NewOTLPHandler(cfg.OTLPEndpoint, level, cfg.OTLPHeaders), nil
+	}
+	return nil, fmt.Errorf("logging: unknown format %q", cfg.Format)
+}
+
+// attrTransformers holds ReplaceAttr hooks registered by name for use
+// from file-loaded configurations.
+var attrTransformers = struct {
+	sync.RWMutex
+	m map[string]func(groups []string, a slog.Attr) slog.Attr
+}{m: map[string]func(groups []string, a slog.Attr) slog.Attr{}}
+
+// RegisterAttrTransformer registers a named ReplaceAttr hook that configs
+// can select via Config.AttrTransformer.
+func RegisterAttrTransformer(name string, fn func(groups []string, a slog.Attr) slog.Attr) {
+	attrTransformers.Lock()
+	defer attrTransformers.Unlock()
+	attrTransformers.m[name] = fn
+}
+
+// resolveReplaceAttr combines cfg's ReplaceAttr hook, named transformer,
+// and timestamp settings into the single hook slog accepts.
+func resolveReplaceAttr(cfg Config) (func(groups []string, a slog.Attr) slog.Attr, error) {
+	fns := make([]func(groups []string, a slog.Attr) slog.Attr, 0, 3)
+	if cfg.ReplaceAttr != nil {
+		fns = append(fns, cfg.ReplaceAttr)
+	}
+	if cfg.AttrTransformer != "" {
+		attrTransformers.RLock()
+		fn, ok := attrTransformers.m[cfg.AttrTransformer]
+		attrTransformers.RUnlock()
+		if !ok {
+			return nil, fmt.Errorf("logging: unknown attr transformer %q", cfg.AttrTransformer)
+		}
+		fns = append(fns, fn)
+	}
+	if fn := timeReplaceAttr(cfg); fn != nil {
+		fns = append(fns, fn)
+	}
+	if fn := fieldNamesReplaceAttr(cfg); fn != nil {
+		fns = append(fns, fn)
+	}
+	if fn := levelReplaceAttr(cfg); fn != nil {
+		fns = append(fns, fn)
+	}
+	if fn := errorReplaceAttr(cfg); fn != nil {
+		fns = append(fns, fn)
+	}
+	if fn := sanitizeReplaceAttr(cfg); fn != nil {
+		fns = append(fns, fn)
+	}
+	redact, err := redactReplaceAttr(cfg)
+	if err != nil {
+		return nil, err
+	}
+	if redact != nil {
+		fns = append(fns, redact)
+	}
+	switch len(fns) {
+	case 0:
+		return nil, nil
+	case 1:
+		return fns[0], nil
+	}
+	return func(groups []string, a slog.Attr) slog.Attr {
+		for _, fn := range fns {
+			a = fn(groups, a)
+			if a.Equal(slog.Attr{}) {
+				return a
+			}
+		}
+		return a
+	}, nil
+}
+
+// fieldNamesReplaceAttr builds a ReplaceAttr hook applying cfg.FieldNames
+// to the built-in keys at the top level. It returns nil when no renames
+// are configured.
+func fieldNamesReplaceAttr(cfg Config) func(groups []string, a slog.Attr) slog.Attr {
+	if len(cfg.FieldNames) == 0 {
+		return nil
+	}
+	names := make(map[string]string, len(cfg.FieldNames))
+	for k, v := range cfg.FieldNames {
+		names[k] = v
+	}
+	return func(groups []string, a slog.Attr) slog.Attr {
+		if len(groups) > 0 {
+			return a
+		}
+		if name, ok := names[a.Key]; ok {
+			a.Key = name
+		}
+		return a
+	}
+}
+
+// levelReplaceAttr builds a ReplaceAttr hook applying cfg.LevelFormat and
+// cfg.LevelLabels to the built-in level attribute. It returns nil when
+// neither is configured.
+func levelReplaceAttr(cfg Config) func(groups []string, a slog.Attr) slog.Attr {
+	if cfg.LevelFormat == "" && len(cfg.LevelLabels) == 0 {
+		return nil
+	}
+	labels := make(map[string]string, len(cfg.LevelLabels))
+	for name, label := range cfg.LevelLabels {
+		if level, err := ParseLevel(name); err == nil {
+			labels[level.String()] = label
+		}
+	}
+	return func(groups []string, a slog.Attr) slog.Attr {
+		if len(groups) > 0 || a.Key != slog.LevelKey {
+			return a
+		}
+		level, ok := a.Value.Any().(slog.Level)
+		if !ok {
+			return a
+		}
+		if label, found := labels[level.String()]; found {
+			a.Value = slog.StringValue(label)
+			return a
+		}
+		switch cfg.LevelFormat {
+		case "lower":
+			a.Value = slog.StringValue(strings.ToLower(level.String()))
+		case "numeric":
+			a.Value = slog.IntValue(int(level))
+		case "", "upper":
+			a.Value = slog.StringValue(level.String())
+		}
+		return a
+	}
+}
+
+// timeReplaceAttr builds a ReplaceAttr hook applying cfg's timestamp
+// format and field name to the built-in time attribute. It returns nil
+// when neither is configured.
+func timeReplaceAttr(cfg Config) func(groups []string, a slog.Attr) slog.Attr {
+	if cfg.TimeFormat == "" && cfg.TimeField == "" {
+		return nil
+	}
+	return func(groups []string, a slog.Attr) slog.Attr {
+		if len(groups) > 0 || a.Key != slog.TimeKey || a.Value.Kind() != slog.KindTime {
+			return a
+		}
+		if cfg.TimeField != "" {
+			a.Key = cfg.TimeField
+		}
+		t := a.Value.Time()
+		switch cfg.TimeFormat {
+		case "":
+		case "unix":
+			a.Value = slog.Int64Value(t.Unix())
+		case "unix-ms":
+			a.Value = slog.Int64Value(t.UnixMilli())
+		default:
+			a.Value = slog.StringValue(t.Format(cfg.TimeFormat))
+		}
+		return a
+	}
+}
+
+func openOutput(cfg Config) (io.Writer, error) {
+	outputs := splitOutputs(cfg.Output)
+	if len(outputs) > 1 {
+		writers := make([]io.Writer, 0, len(outputs))
+		for _, out := range outputs {
+			single := cfg
+			single.Output = out
+			w, err := openSingleOutput(single)
+			if err != nil {
+				return nil, err
+			}
+			writers = append(writers, w)
+		}
+		return NewMultiWriter(writers...), nil
+	}
+	return openSingleOutput(cfg)
+}
+
+func openSingleOutput(cfg Config) (io.Writer, error) {
+	switch cfg.Output {
+	case "", "stdout":
+		return os.Stdout, nil
+	case "stderr":
+		return os.Stderr, nil
+	case "journald":
+		return newNetWriter("unixgram", journaldSocket), nil
+	}
+	if strings.HasPrefix(cfg.Output, "syslog://") || strings.HasPrefix(cfg.Output, "syslog+tcp://") {
+		return openSyslogOutput(cfg.Output)
+	}
+	if strings.HasPrefix(cfg.Output, "tcp://") || strings.HasPrefix(cfg.Output, "udp://") {
+		return openNetworkOutput(cfg.Output)
+	}
+	if strings.HasPrefix(cfg.Output, "gelf://") || strings.HasPrefix(cfg.Output, "gelf+tcp://") || strings.HasPrefix(cfg.Output, "gelf+tls://") {
+		return openGELFOutput(cfg.Output)
+	}
+	// A local collector socket: "unixgram:///run/collector.sock" for one
+	// datagram per record, "unix:///run/collector.sock" for a stream.
+	if path, ok := strings.CutPrefix(cfg.Output, "unixgram://"); ok {
+		return newNetWriter("unixgram", path), nil
+	}
+	if path, ok := strings.CutPrefix(cfg.Output, "unix://"); ok {
+		return newNetWriter("unix", path), nil
+	}
+	if cfg.Rotate {
+		opts := []RotateOption{WithMaxDays(cfg.MaxDays)}
+		if cfg.Symlink {
+			opts = append(opts, WithSymlink(true))
+		}
+		if cfg.DateFormat != "" {
+			opts = append(opts, WithDateFormat(cfg.DateFormat))
+		}
+		if cfg.UseUTC {
+			opts = append(opts, WithUTC(true))
+		}
+		if cfg.RotateAt != "" {
+			opts = append(opts, WithRotateAt(cfg.RotateAt))
+		}
+		return NewDailyRotateWriter(cfg.Output, opts...)
+	}
+	return os.OpenFile(cfg.Output, os.O_CREATE|os.O_APPEND|os.O_WRONLY, 0o644)
+}
+
+func (l *SlogLogger) context() context.Context {
+	if l.ctx != nil {
+		return l.ctx
+	}
+	return context.Background()
+}
+
+// log builds and emits one record. It captures the program counter of the
+// caller's caller so AddSource reports the application call site rather
+// than this wrapper.
+func (l *SlogLogger) log(level slog.Level, msg string, args ...any) {
+	ctx := l.context()
+	h := l.logger.Handler()
+	if override, ok := LevelFromContext(ctx); ok {
+		// A per-request override bypasses the configured minimum.
+		if level < override {
+			return
+		}
+	} else if !h.Enabled(ctx, level) {
+		return
+	}
+	var pc uintptr
+	if l.addSource {
+		var pcs [1]uintptr
+		// Skip runtime.Callers, log, and the exported wrapper method.
+		runtime.Callers(3, pcs[:])
+		pc = pcs[0]
+	}
+	r := slog.NewRecord(time.Now(), level, msg, pc)
+	r.Add(args...)
+	if l.ctx != nil {
+		r.AddAttrs(contextAttrs(ctx)...)
+	}
+	if l.stackMin != nil && level >= *l.stackMin {
+		r.AddAttrs(slog.String("stack", captureStack(2, l.stackDepth)))
+	}
+	if len(l.hooks) > 0 || !l.subs.empty() {
+		rec := Record{Time: r.Time, Level: r.Level, Message: r.Message, PC: r.PC}
+		r.Attrs(func(a slog.Attr) bool {
+			rec.Attrs = append(rec.Attrs, a)
+			return true
+		})
+		rec, keep := runHooks(ctx, l.hooks, rec)
+		if !keep {
+			metricDropped()
+			return
+		}
+		l.subs.publish(rec)
+		r = slog.NewRecord(rec.Time, rec.Level, rec.Message, rec.PC)
+		r.AddAttrs(rec.Attrs...)
+	}
+	metricRecord(level)
+	if err := h.Handle(ctx, r); err != nil {
+		metricWriteError()
+	}
+}
+
+// Enabled reports whether records at level would be emitted, so callers
+// can skip building expensive arguments for filtered levels.
+func (l *SlogLogger) Enabled(level Level) bool {
+	return l.logger.Handler().Enabled(l.context(), level)
+}
+
+// DebugEnabled reports whether LevelDebug records would be emitted.
+func (l *SlogLogger) DebugEnabled() bool { return l.Enabled(LevelDebug) }
+
+// InfoEnabled reports whether LevelInfo records would be emitted.
+func (l *SlogLogger) InfoEnabled() bool { return l.Enabled(LevelInfo) }
+
+// WarnEnabled reports whether LevelWarn records would be emitted.
+func (l *SlogLogger) WarnEnabled() bool { return l.Enabled(LevelWarn) }
+
+// ErrorEnabled reports whether LevelError records would be emitted.
+func (l *SlogLogger) ErrorEnabled() bool { return l.Enabled(LevelError) }
+
+// Debug logs at LevelDebug.
+func (l *SlogLogger) Debug(msg string, args ...any) {
+	l.log(LevelDebug, msg, args...)
+}
+
+// Info logs at LevelInfo.
+func (l *SlogLogger) Info(msg string, args ...any) {
+	l.log(LevelInfo, msg, args...)
+}
+
+// Warn logs at LevelWarn.
+func (l *SlogLogger) Warn(msg string, args ...any) {
+	l.log(LevelWarn, msg, args...)
+}
+
+// Error logs at LevelError.
+func (l *SlogLogger) Error(msg string, args ...any) {
+	l.log(LevelError, msg, args...)
+}
+
+// SetLevel changes the minimum level on a live logger. Clones made with
+// With/WithGroup/WithContext share the same level. It is a no-op on
+// loggers built around a caller-supplied handler.
+func (l *SlogLogger) SetLevel(level Level) {
+	if l.level != nil {
+		l.level.Set(level)
+	}
+}
+
+// GetLevel reports the current minimum level.
+func (l *SlogLogger) GetLevel() Level {
+	if l.level != nil {
+		return l.level.Level()
+	}
+	return LevelInfo
+}
+
+// Flush forces buffered output down to the underlying writer and on to
+// disk when the writer supports it.
+func (l *SlogLogger) Flush(ctx context.Context) error {
+	switch out := l.out.(type) {
+	case Syncer:
+		return out.Flush(ctx)
+	case interface{ Sync() error }:
+		return out.Sync()
+	}
+	return nil
+}
+
+// Close flushes buffered output and closes the writer when the logger
+// opened it itself (as NewFromConfig does for files and rotate writers),
+// releasing the file descriptor. Loggers over caller-supplied writers
+// only flush.
+func (l *SlogLogger) Close() error {
+	err := l.Flush(context.Background())
+	if l.ownsOut {
+		if c, ok := l.out.(io.Closer); ok {
+			if cerr := c.Close(); err == nil {
+				err = cerr
+			}
+		}
+	}
+	return err
+}
+
+// Rotate forces a rotation on the owned writer when it supports one, as
+// DailyRotateWriter does.
+func (l *SlogLogger) Rotate() error {
+	if r, ok := l.out.(interface{ Rotate() error }); ok {
+		return r.Rotate()
+	}
+	return nil
+}
+
+// With returns a copy of the logger with args added to every record.
+func (l *SlogLogger) With(args ...any) Logger {
+	clone := *l
+	clone.logger = l.logger.With(args...)
+	return &clone
+}
+
+// WithError returns a copy of the logger attaching err under the
+// canonical "error" key; a nil err returns the receiver unchanged.
+func (l *SlogLogger) WithError(err error) Logger {
+	if err == nil {
+		return l
+	}
+	return l.With(Err(err))
+}
+
+// WithGroup returns a copy of the logger nesting subsequent attributes
+// under name.
+func (l *SlogLogger) WithGroup(name string) Logger {
+	clone := *l
+	clone.logger = l.logger.WithGroup(name)
+	return &clone
+}
+
+// WithContext returns a copy of the logger that logs records with ctx.
+func (l *SlogLogger) WithContext(ctx context.Context) Logger {
+	clone := *l
+	clone.ctx = ctx
+	return &clone
+}
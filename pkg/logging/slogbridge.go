@@ -0,0 +1,67 @@
+package logging
+
+import (
+	"context"
+	"log/slog"
+)
+
+// Slog returns a *slog.Logger backed by this logger's pipeline, so
+// third-party libraries that take a *slog.Logger share the same
+// formatting, rotation, hooks and context extraction.
+func (l *SlogLogger) Slog() *slog.Logger {
+	return slog.New(l.Handler())
+}
+
+// Handler returns the logger's pipeline as a plain slog.Handler, running
+// hooks and context extractors in front of the configured handler.
+func (l *SlogLogger) Handler() slog.Handler {
+	return &pipelineHandler{inner: l.logger.Handler(), hooks: l.hooks}
+}
+
+// pipelineHandler adapts the SlogLogger pipeline to slog.Handler, so
+// records logged through slog directly still pass the hook chain and pick
+// up context attributes.
+type pipelineHandler struct {
+	inner slog.Handler
+	hooks []Hook
+}
+
+// Enabled implements slog.Handler.
+func (h *pipelineHandler) Enabled(ctx context.Context, level slog.Level) bool {
+	return h.inner.Enabled(ctx, level)
+}
+
+// Handle implements slog.Handler.
+func (h *pipelineHandler) Handle(ctx context.Context, r slog.Record) error {
+	if ctx != nil {
+		r.AddAttrs(contextAttrs(ctx)...)
+	}
+	if len(h.hooks) > 0 {
+		rec := Record{Time: r.Time, Level: r.Level, Message: r.Message, PC: r.PC}
+		r.Attrs(func(a slog.Attr) bool {
+			rec.Attrs = append(rec.Attrs, a)
+			return true
+		})
+		rec, keep := runHooks(ctx, h.hooks, rec)
+		if !keep {
+			return nil
+		}
+		r = slog.NewRecord(rec.Time, rec.Level, rec.Message, rec.PC)
+		r.AddAttrs(rec.Attrs...)
+	}
+	return h.inner.Handle(ctx, r)
+}
+
+// WithAttrs implements slog.Handler.
+func (h *pipelineHandler) WithAttrs(attrs []slog.Attr) slog.Handler {
+	clone := *h
+	clone.inner = h.inner.WithAttrs(attrs)
+	return &clone
+}
+
+// WithGroup implements slog.Handler.
+func (h *pipelineHandler) WithGroup(name string) slog.Handler {
+	clone := *h
+	clone.inner = h.inner.WithGroup(name)
+	return &clone
+}
@@ -0,0 +1,152 @@
+package logging
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"fmt"
+	"log/slog"
+	"strings"
+	"sync"
+	"time"
+)
+
+// sqlitePruneEvery is how many inserts pass between pruning sweeps.
+const sqlitePruneEvery = 256
+
+// SQLiteSinkConfig controls the SQLite sink.
+type SQLiteSinkConfig struct {
+	// Table is the table name; empty means "logs".
+	Table string
+	// MaxRecords prunes the oldest rows once the table exceeds this
+	// count, bounding the database size on edge devices; 0 keeps all.
+	MaxRecords int
+	// Level is the minimum level stored; nil means Info.
+	Level slog.Leveler
+}
+
+// SQLiteHandler writes records into a local SQLite database so recent
+// logs can be queried ad hoc with plain SQL on edge devices. The caller
+// opens the database with their SQLite driver of choice and hands over
+// the *sql.DB; the handler creates the table, switches the journal to WAL
+// mode for concurrent readers, and prunes by row count:
+//
+//	db, _ := sql.Open("sqlite3", "/var/lib/app/logs.db")
+//	h, err := logging.NewSQLiteHandler(db, logging.SQLiteSinkConfig{MaxRecords: 100000})
+//	logger := logging.NewSlogLoggerWithHandler(h)
+//
+// Schema: time (RFC 3339 TEXT), level TEXT, msg TEXT, attrs TEXT (JSON).
+type SQLiteHandler struct {
+	db     *sql.DB
+	cfg    SQLiteSinkConfig
+	attrs  []slog.Attr
+	groups []string
+	state  *sqliteState
+}
+
+// sqliteState is shared by an SQLiteHandler and its WithAttrs/WithGroup
+// clones.
+type sqliteState struct {
+	mu      sync.Mutex
+	inserts int
+}
+
+// NewSQLiteHandler prepares the database and returns the handler.
+func NewSQLiteHandler(db *sql.DB, cfg SQLiteSinkConfig) (*SQLiteHandler, error) {
+	if db == nil {
+		return nil, fmt.Errorf("logging: sqlite sink requires a database")
+	}
+	if cfg.Table == "" {
+		cfg.Table = "logs"
+	}
+	if _, err := db.Exec("PRAGMA journal_mode=WAL"); err != nil {
+		return nil, fmt.Errorf("logging: sqlite WAL mode: %w", err)
+	}
+	create := fmt.Sprintf(`CREATE TABLE IF NOT EXISTS %s (
+		id INTEGER PRIMARY KEY AUTOINCREMENT,
+		time TEXT NOT NULL,
+		level TEXT NOT NULL,
+		msg TEXT NOT NULL,
+		attrs TEXT
+	)`, cfg.Table)
+	if _, err := db.Exec(create); err != nil {
+		return nil, fmt.Errorf("logging: sqlite schema: %w", err)
+	}
+	return &SQLiteHandler{db: db, cfg: cfg, state: &sqliteState{}}, nil
+}
+
+// Enabled implements slog.Handler.
+func (h *SQLiteHandler) Enabled(_ context.Context, level slog.Level) bool {
+	min := slog.Level(LevelInfo)
+	if h.cfg.Level != nil {
+		min = h.cfg.Level.Level()
+	}
+	return level >= min
+}
+
+// Handle implements slog.Handler.
+func (h *SQLiteHandler) Handle(ctx context.Context, r slog.Record) error {
+	attrs := map[string]any{}
+	for _, a := range h.attrs {
+		attrs[a.Key] = a.Value.Resolve().Any()
+	}
+	prefix := strings.Join(h.groups, ".")
+	r.Attrs(func(a slog.Attr) bool {
+		key := a.Key
+		if prefix != "" {
+			key = prefix + "." + key
+		}
+		attrs[key] = a.Value.Resolve().Any()
+		return true
+	})
+	var attrsJSON any
+	if len(attrs) > 0 {
+		data, err := json.Marshal(attrs)
+		if err != nil {
+			return err
+		}
+		attrsJSON = string(data)
+	}
+	insert := fmt.Sprintf("INSERT INTO %s (time, level, msg, attrs) VALUES (?, ?, ?, ?)", h.cfg.Table)
+	if _, err := h.db.ExecContext(ctx, insert, r.Time.UTC().Format(time.RFC3339Nano), r.Level.String(), r.Message, attrsJSON); err != nil {
+		return err
+	}
+	return h.maybePrune(ctx)
+}
+
+// maybePrune trims the oldest rows every sqlitePruneEvery inserts.
+func (h *SQLiteHandler) maybePrune(ctx context.Context) error {
+	if h.cfg.MaxRecords <= 0 {
+		return nil
+	}
+	h.state.mu.Lock()
+	h.state.inserts++
+	due := h.state.inserts >= sqlitePruneEvery
+	if due {
+		h.state.inserts = 0
+	}
+	h.state.mu.Unlock()
+	if !due {
+		return nil
+	}
+	prune := fmt.Sprintf("DELETE FROM %s WHERE id <= (SELECT MAX(id) FROM %s) - ?", h.cfg.Table, h.cfg.Table)
+	_, err := h.db.ExecContext(ctx, prune, h.cfg.MaxRecords)
+	return err
+}
+
+// WithAttrs implements slog.Handler.
+func (h *SQLiteHandler) WithAttrs(attrs []slog.Attr) slog.Handler {
+	clone := *h
+	clone.attrs = append(append([]slog.Attr{}, h.attrs...), qualifyAll(h.groups, attrs)...)
+	return &clone
+}
+
+// WithGroup implements slog.Handler.
+func (h *SQLiteHandler) WithGroup(name string) slog.Handler {
+	if name == "" {
+		return h
+	}
+	clone := *h
+	clone.groups = append(append([]string{}, h.groups...), name)
+	return &clone
+}
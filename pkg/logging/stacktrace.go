@@ -0,0 +1,40 @@
+package logging
+
+import (
+	"fmt"
+	"runtime"
+	"strings"
+)
+
+// defaultStackDepth bounds captured stack traces when no depth is
+// configured.
+const defaultStackDepth = 16
+
+// captureStack formats the current goroutine's stack, skipping skip
+// frames above the caller and keeping at most maxFrames frames. Frames
+// inside this package are omitted so traces start at the application call
+// site.
+func captureStack(skip, maxFrames int) string {
+	if maxFrames <= 0 {
+		maxFrames = defaultStackDepth
+	}
+	pcs := make([]uintptr, maxFrames+8)
+	n := runtime.Callers(skip+2, pcs)
+	if n == 0 {
+		return ""
+	}
+	frames := runtime.CallersFrames(pcs[:n])
+	var b strings.Builder
+	count := 0
+	for count < maxFrames {
+		frame, more := frames.Next()
+		if !strings.Contains(frame.Function, "yuango/pkg/logging.") {
+			fmt.Fprintf(&b, "%s\n\t%s:%d\n", frame.Function, frame.File, frame.Line)
+			count++
+		}
+		if !more {
+			break
+		}
+	}
+	return strings.TrimRight(b.String(), "\n")
+}
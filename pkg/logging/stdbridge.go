@@ -0,0 +1,66 @@
+package logging
+
+import (
+	"log"
+	"strings"
+)
+
+// NewStdBridge returns a stdlib *log.Logger whose output is re-emitted
+// through l. Conventional level prefixes on each line — "ERROR: ...",
+// "[warn] ..." — select the record level and are stripped; lines without
+// a recognizable prefix log at defaultLevel. This merges code and
+// dependencies still using the standard logger into structured output.
+func NewStdBridge(l Logger, defaultLevel Level) *log.Logger {
+	return log.New(&stdBridgeWriter{l: l, def: defaultLevel}, "", 0)
+}
+
+// stdBridgeWriter receives one line per log.Logger call.
+type stdBridgeWriter struct {
+	l   Logger
+	def Level
+}
+
+// Write implements io.Writer.
+func (w *stdBridgeWriter) Write(p []byte) (int, error) {
+	msg := strings.TrimRight(string(p), "\n")
+	level, msg := parseLevelPrefix(msg, w.def)
+	switch {
+	case level >= LevelError:
+		w.l.Error(msg)
+	case level >= LevelWarn:
+		w.l.Warn(msg)
+	case level >= LevelInfo:
+		w.l.Info(msg)
+	default:
+		w.l.Debug(msg)
+	}
+	return len(p), nil
+}
+
+// parseLevelPrefix recognizes "LEVEL:" and "[LEVEL]" prefixes in either
+// case, returning the level and the line with the prefix removed.
+func parseLevelPrefix(msg string, def Level) (Level, string) {
+	rest := msg
+	var token string
+	switch {
+	case strings.HasPrefix(rest, "["):
+		end := strings.IndexByte(rest, ']')
+		if end < 0 {
+			return def, msg
+		}
+		token = rest[1:end]
+		rest = rest[end+1:]
+	default:
+		end := strings.IndexByte(rest, ':')
+		if end < 0 {
+			return def, msg
+		}
+		token = rest[:end]
+		rest = rest[end+1:]
+	}
+	level, err := ParseLevel(token)
+	if err != nil || strings.TrimSpace(token) == "" {
+		return def, msg
+	}
+	return level, strings.TrimLeft(rest, " ")
+}
@@ -0,0 +1,61 @@
+package logging
+
+import "sync"
+
+// Subscriber receives an in-process copy of every emitted record, after
+// hooks have run. Subscribers must not block: they run inline on the
+// logging call.
+type Subscriber func(Record)
+
+// subscriberSet is shared by a logger and its clones.
+type subscriberSet struct {
+	mu   sync.RWMutex
+	m    map[int]Subscriber
+	next int
+}
+
+func (s *subscriberSet) add(fn Subscriber) (cancel func()) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.m == nil {
+		s.m = map[int]Subscriber{}
+	}
+	id := s.next
+	s.next++
+	s.m[id] = fn
+	return func() {
+		s.mu.Lock()
+		defer s.mu.Unlock()
+		delete(s.m, id)
+	}
+}
+
+func (s *subscriberSet) empty() bool {
+	if s == nil {
+		return true
+	}
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	return len(s.m) == 0
+}
+
+func (s *subscriberSet) publish(r Record) {
+	if s == nil {
+		return
+	}
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	for _, fn := range s.m {
+		fn(r)
+	}
+}
+
+// Subscribe registers fn to receive a copy of every record this logger
+// (and its With/WithContext clones) emits, for live debug UIs and custom
+// alerting. The returned function cancels the subscription.
+func (l *SlogLogger) Subscribe(fn Subscriber) (cancel func()) {
+	if l.subs == nil {
+		l.subs = &subscriberSet{}
+	}
+	return l.subs.add(fn)
+}
@@ -0,0 +1,75 @@
+package logging
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"log/slog"
+	"os"
+	"strings"
+	"testing"
+	"time"
+)
+
+func syslogLine(t *testing.T, opts SyslogOptions, r slog.Record) string {
+	t.Helper()
+	var buf bytes.Buffer
+	h := NewSyslogHandler(&buf, opts)
+	if err := h.Handle(context.Background(), r); err != nil {
+		t.Fatalf("Handle: %v", err)
+	}
+	return buf.String()
+}
+
+func TestSyslogRFC5424Line(t *testing.T) {
+	ts := time.Date(2024, 5, 1, 12, 30, 0, 123456000, time.UTC)
+	r := slog.NewRecord(ts, slog.Level(LevelWarn), "disk almost full", 0)
+	r.AddAttrs(slog.String("mount", "/var"))
+	line := syslogLine(t, SyslogOptions{Hostname: "web1", AppName: "api"}, r)
+
+	// local0 (16) * 8 + warning severity (4).
+	want := fmt.Sprintf("<132>1 2024-05-01T12:30:00.123456Z web1 api %d - [yuango@0 mount=\"/var\"] disk almost full\n", os.Getpid())
+	if line != want {
+		t.Errorf("line = %q\nwant   %q", line, want)
+	}
+}
+
+func TestSyslogRFC5424ParamValueEscaping(t *testing.T) {
+	r := slog.NewRecord(time.Now(), slog.Level(LevelInfo), "msg", 0)
+	r.AddAttrs(slog.String("q", `say "hi" \ [done]`))
+	line := syslogLine(t, SyslogOptions{Hostname: "h", AppName: "a"}, r)
+
+	// RFC 5424 6.3.3: '"', '\' and ']' are backslash-escaped inside
+	// PARAM-VALUE; nothing else is touched.
+	want := `q="say \"hi\" \\ [done\]"`
+	if !strings.Contains(line, want) {
+		t.Errorf("line %q does not contain %q", line, want)
+	}
+}
+
+func TestSyslogRFC5424NoAttrs(t *testing.T) {
+	r := slog.NewRecord(time.Now(), slog.Level(LevelInfo), "plain", 0)
+	line := syslogLine(t, SyslogOptions{Hostname: "h", AppName: "a"}, r)
+	if !strings.Contains(line, " - - plain\n") {
+		t.Errorf("line %q should carry NILVALUE structured data", line)
+	}
+}
+
+func TestSyslogRFC3164Line(t *testing.T) {
+	ts := time.Date(2024, 5, 1, 12, 30, 0, 0, time.UTC)
+	r := slog.NewRecord(ts, slog.Level(LevelError), "boom", 0)
+	r.AddAttrs(slog.Int("code", 7))
+	line := syslogLine(t, SyslogOptions{Hostname: "web1", AppName: "api", RFC3164: true}, r)
+
+	// local0 (16) * 8 + error severity (3).
+	want := fmt.Sprintf("<131>May  1 12:30:00 web1 api[%d]: boom code=7\n", os.Getpid())
+	if line != want {
+		t.Errorf("line = %q\nwant   %q", line, want)
+	}
+}
+
+func TestSDEscapeName(t *testing.T) {
+	if got := sdEscapeName(`a=b c]d"e`); got != "a_b_c_d_e" {
+		t.Errorf("sdEscapeName = %q, want a_b_c_d_e", got)
+	}
+}
@@ -0,0 +1,81 @@
+package logging
+
+import (
+	"context"
+	"log/slog"
+	"strings"
+	"testing"
+)
+
+// TBLogger routes records through testing.TB.Logf with level prefixes, so
+// log output is attached to the test that produced it and only shown when
+// the test fails or -v is set.
+type TBLogger struct {
+	tb     testing.TB
+	attrs  []slog.Attr
+	groups []string
+}
+
+var _ Logger = (*TBLogger)(nil)
+
+// NewTBLogger returns a Logger writing through tb.
+func NewTBLogger(tb testing.TB) *TBLogger {
+	return &TBLogger{tb: tb}
+}
+
+func (l *TBLogger) logf(level Level, msg string, args ...any) {
+	l.tb.Helper()
+	attrs := append(append([]slog.Attr{}, l.attrs...), qualifyAll(l.groups, argsToAttrs(args))...)
+	var pairs []string
+	for _, a := range attrs {
+		pairs = append(pairs, a.Key+"="+formatConsoleValue(a.Value.Resolve()))
+	}
+	line := msg
+	if len(pairs) > 0 {
+		line += " " + strings.Join(pairs, " ")
+	}
+	l.tb.Logf("%s %s", level.String(), line)
+}
+
+// Debug implements Logger.
+func (l *TBLogger) Debug(msg string, args ...any) { l.tb.Helper(); l.logf(LevelDebug, msg, args...) }
+
+// Info implements Logger.
+func (l *TBLogger) Info(msg string, args ...any) { l.tb.Helper(); l.logf(LevelInfo, msg, args...) }
+
+// Warn implements Logger.
+func (l *TBLogger) Warn(msg string, args ...any) { l.tb.Helper(); l.logf(LevelWarn, msg, args...) }
+
+// Error implements Logger.
+func (l *TBLogger) Error(msg string, args ...any) { l.tb.Helper(); l.logf(LevelError, msg, args...) }
+
+// With implements Logger.
+func (l *TBLogger) With(args ...any) Logger {
+	clone := *l
+	clone.attrs = append(append([]slog.Attr{}, l.attrs...), qualifyAll(l.groups, argsToAttrs(args))...)
+	return &clone
+}
+
+// WithGroup implements Logger.
+func (l *TBLogger) WithGroup(name string) Logger {
+	if name == "" {
+		return l
+	}
+	clone := *l
+	clone.groups = append(append([]string{}, l.groups...), name)
+	return &clone
+}
+
+// WithError implements Logger.
+func (l *TBLogger) WithError(err error) Logger {
+	if err == nil {
+		return l
+	}
+	return l.With(Err(err))
+}
+
+// WithContext implements Logger. The context carries no meaning for test
+// output, so it is ignored.
+func (l *TBLogger) WithContext(ctx context.Context) Logger {
+	return l
+}
@@ -0,0 +1,111 @@
+package logging
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"log/slog"
+	"strings"
+	"sync"
+	"text/template"
+	"time"
+)
+
+// TemplateRecord is the data a template formatter line is rendered from.
+type TemplateRecord struct {
+	// Time is the record timestamp.
+	Time time.Time
+	// Level is the level label, e.g. "INFO".
+	Level string
+	// Message is the record message.
+	Message string
+	// Attrs is the attributes rendered as space-separated key=value pairs.
+	Attrs string
+	// AttrMap gives access to individual attribute values by key.
+	AttrMap map[string]any
+}
+
+// TemplateHandler renders each record through a Go text/template, e.g.
+// "{{.Time.Format \"15:04:05\"}} [{{.Level}}] {{.Message}} {{.Attrs}}", so
+// legacy log formats can be matched exactly. Selectable via Config.Format
+// "template" together with Config.Template.
+type TemplateHandler struct {
+	tmpl   *template.Template
+	w      io.Writer
+	mu     *sync.Mutex
+	level  slog.Leveler
+	attrs  []slog.Attr
+	groups []string
+}
+
+// NewTemplateHandler compiles layout and returns a TemplateHandler
+// writing to w at the given minimum level (LevelInfo when nil).
+func NewTemplateHandler(w io.Writer, level slog.Leveler, layout string) (*TemplateHandler, error) {
+	tmpl, err := template.New("logline").Parse(layout)
+	if err != nil {
+		return nil, fmt.Errorf("logging: bad template: %w", err)
+	}
+	return &TemplateHandler{tmpl: tmpl, w: w, mu: &sync.Mutex{}, level: level}, nil
+}
+
+// Enabled implements slog.Handler.
+func (h *TemplateHandler) Enabled(_ context.Context, level slog.Level) bool {
+	min := slog.Level(LevelInfo)
+	if h.level != nil {
+		min = h.level.Level()
+	}
+	return level >= min
+}
+
+// Handle implements slog.Handler.
+func (h *TemplateHandler) Handle(_ context.Context, r slog.Record) error {
+	attrs := make([]slog.Attr, 0, len(h.attrs)+r.NumAttrs())
+	attrs = append(attrs, h.attrs...)
+	prefix := strings.Join(h.groups, ".")
+	r.Attrs(func(a slog.Attr) bool {
+		if prefix != "" {
+			a.Key = prefix + "." + a.Key
+		}
+		attrs = append(attrs, a)
+		return true
+	})
+	var pairs []string
+	attrMap := make(map[string]any, len(attrs))
+	for _, a := range attrs {
+		v := a.Value.Resolve()
+		pairs = append(pairs, a.Key+"="+formatConsoleValue(v))
+		attrMap[a.Key] = v.Any()
+	}
+	data := TemplateRecord{
+		Time:    r.Time,
+		Level:   r.Level.String(),
+		Message: r.Message,
+		Attrs:   strings.Join(pairs, " "),
+		AttrMap: attrMap,
+	}
+	var b strings.Builder
+	if err := h.tmpl.Execute(&b, data); err != nil {
+		return err
+	}
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	_, err := io.WriteString(h.w, strings.TrimRight(b.String(), "\n")+"\n")
+	return err
+}
+
+// WithAttrs implements slog.Handler.
+func (h *TemplateHandler) WithAttrs(attrs []slog.Attr) slog.Handler {
+	clone := *h
+	clone.attrs = append(append([]slog.Attr{}, h.attrs...), qualifyAll(h.groups, attrs)...)
+	return &clone
+}
+
+// WithGroup implements slog.Handler.
+func (h *TemplateHandler) WithGroup(name string) slog.Handler {
+	if name == "" {
+		return h
+	}
+	clone := *h
+	clone.groups = append(append([]string{}, h.groups...), name)
+	return &clone
+}
@@ -0,0 +1,199 @@
+package logging
+
+import (
+	"context"
+	"io"
+	"log/slog"
+	"strings"
+	"sync"
+)
+
+// TenantSplitConfig controls attribute-based file splitting.
+type TenantSplitConfig struct {
+	// Key is the attribute the split is keyed on, e.g. "tenant_id" or
+	// "component".
+	Key string
+	// PathPattern is the per-tenant file path with a "{tenant}"
+	// placeholder, e.g. "/var/log/app/{tenant}/app.log".
+	PathPattern string
+	// Default names the bucket for records without the attribute; empty
+	// means "default".
+	Default string
+	// RotateOptions configure each tenant's DailyRotateWriter (retention,
+	// symlinks, ...).
+	RotateOptions []RotateOption
+	// NewHandler builds the handler for one tenant's writer; nil means a
+	// JSON handler at Info.
+	NewHandler func(w io.Writer) slog.Handler
+}
+
+// tenantState shares the per-tenant writers across handler clones.
+type tenantState struct {
+	mu      sync.Mutex
+	writers map[string]*DailyRotateWriter
+}
+
+// TenantSplitHandler routes records into separate rotated files keyed by
+// an attribute, so one process can serve many tenants and still produce
+// per-tenant logs, each with its own rotation and retention.
+type TenantSplitHandler struct {
+	cfg      TenantSplitConfig
+	state    *tenantState
+	mu       *sync.Mutex
+	handlers map[string]slog.Handler
+	attrs    []slog.Attr
+	groups   []string
+}
+
+// NewTenantSplitHandler returns a splitting handler; writers are created
+// lazily on the first record for each tenant.
+func NewTenantSplitHandler(cfg TenantSplitConfig) *TenantSplitHandler {
+	if cfg.Default == "" {
+		cfg.Default = "default"
+	}
+	return &TenantSplitHandler{
+		cfg:      cfg,
+		state:    &tenantState{writers: map[string]*DailyRotateWriter{}},
+		mu:       &sync.Mutex{},
+		handlers: map[string]slog.Handler{},
+	}
+}
+
+// Enabled implements slog.Handler. Tenancy is orthogonal to level, so the
+// decision is delegated to the per-tenant handlers at Handle time.
+func (h *TenantSplitHandler) Enabled(_ context.Context, level slog.Level) bool {
+	return true
+}
+
+// Handle implements slog.Handler.
+func (h *TenantSplitHandler) Handle(ctx context.Context, r slog.Record) error {
+	tenant := h.cfg.Default
+	lookup := func(a slog.Attr) bool {
+		if a.Key == h.cfg.Key {
+			tenant = sanitizeTenant(a.Value.Resolve().String())
+			return false
+		}
+		return true
+	}
+	for _, a := range h.attrs {
+		if !lookup(a) {
+			break
+		}
+	}
+	r.Attrs(lookup)
+	inner, err := h.handler(tenant)
+	if err != nil {
+		return err
+	}
+	if !inner.Enabled(ctx, r.Level) {
+		return nil
+	}
+	return inner.Handle(ctx, r)
+}
+
+// handler returns (building if needed) the handler for one tenant, with
+// this clone's accumulated attrs and groups replayed onto it.
+func (h *TenantSplitHandler) handler(tenant string) (slog.Handler, error) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	if inner, ok := h.handlers[tenant]; ok {
+		return inner, nil
+	}
+	w, err := h.writer(tenant)
+	if err != nil {
+		return nil, err
+	}
+	var inner slog.Handler
+	if h.cfg.NewHandler != nil {
+		inner = h.cfg.NewHandler(w)
+	} else {
+		inner = slog.NewJSONHandler(w, nil)
+	}
+	if len(h.attrs) > 0 {
+		inner = inner.WithAttrs(h.attrs)
+	}
+	for _, g := range h.groups {
+		inner = inner.WithGroup(g)
+	}
+	h.handlers[tenant] = inner
+	return inner, nil
+}
+
+// writer returns the shared rotate writer for one tenant.
+func (h *TenantSplitHandler) writer(tenant string) (*DailyRotateWriter, error) {
+	h.state.mu.Lock()
+	defer h.state.mu.Unlock()
+	if w, ok := h.state.writers[tenant]; ok {
+		return w, nil
+	}
+	path := strings.ReplaceAll(h.cfg.PathPattern, "{tenant}", tenant)
+	w, err := NewDailyRotateWriter(path, h.cfg.RotateOptions...)
+	if err != nil {
+		return nil, err
+	}
+	h.state.writers[tenant] = w
+	return w, nil
+}
+
+// Flush syncs every tenant's writer.
+func (h *TenantSplitHandler) Flush(ctx context.Context) error {
+	h.state.mu.Lock()
+	defer h.state.mu.Unlock()
+	var firstErr error
+	for _, w := range h.state.writers {
+		if err := w.Sync(); err != nil && firstErr == nil {
+			firstErr = err
+		}
+	}
+	return firstErr
+}
+
+// Close closes every tenant's writer.
+func (h *TenantSplitHandler) Close() error {
+	h.state.mu.Lock()
+	defer h.state.mu.Unlock()
+	var firstErr error
+	for _, w := range h.state.writers {
+		if err := w.Close(); err != nil && firstErr == nil {
+			firstErr = err
+		}
+	}
+	h.state.writers = map[string]*DailyRotateWriter{}
+	return firstErr
+}
+
+// WithAttrs implements slog.Handler.
+func (h *TenantSplitHandler) WithAttrs(attrs []slog.Attr) slog.Handler {
+	clone := *h
+	clone.mu = &sync.Mutex{}
+	clone.handlers = map[string]slog.Handler{}
+	clone.attrs = append(append([]slog.Attr{}, h.attrs...), qualifyAll(h.groups, attrs)...)
+	return &clone
+}
+
+// WithGroup implements slog.Handler.
+func (h *TenantSplitHandler) WithGroup(name string) slog.Handler {
+	if name == "" {
+		return h
+	}
+	clone := *h
+	clone.mu = &sync.Mutex{}
+	clone.handlers = map[string]slog.Handler{}
+	clone.groups = append(append([]string{}, h.groups...), name)
+	return &clone
+}
+
+// sanitizeTenant keeps tenant values usable as path components.
+func sanitizeTenant(s string) string {
+	s = strings.Map(func(r rune) rune {
+		switch r {
+		case '/', '\\', ':', 0:
+			return '_'
+		}
+		return r
+	}, s)
+	if s == "" || s == "." || s == ".." {
+		return "default"
+	}
+	return s
+}
@@ -0,0 +1,145 @@
+package logging
+
+import (
+	"context"
+	"log/slog"
+	"strings"
+	"sync"
+	"time"
+)
+
+// CapturedRecord is one entry recorded by a TestLogger.
+type CapturedRecord struct {
+	// Time is when the entry was logged.
+	Time time.Time
+	// Level is the entry severity.
+	Level Level
+	// Message is the entry message.
+	Message string
+	// Attrs are the entry attributes, including those from With.
+	Attrs []slog.Attr
+	// Ctx is the context attached via WithContext, if any.
+	Ctx context.Context
+}
+
+// testLogState is shared by a TestLogger and its With/WithGroup clones so
+// all of them record into the same slice.
+type testLogState struct {
+	mu      sync.Mutex
+	records []CapturedRecord
+}
+
+// TestLogger is a Logger that captures records in memory instead of
+// writing them anywhere, for table-driven tests that assert on what was
+// logged rather than grepping log files.
+type TestLogger struct {
+	state  *testLogState
+	attrs  []slog.Attr
+	groups []string
+	ctx    context.Context
+}
+
+var _ Logger = (*TestLogger)(nil)
+
+// NewTestLogger returns an empty TestLogger.
+func NewTestLogger() *TestLogger {
+	return &TestLogger{state: &testLogState{}}
+}
+
+// Records returns a copy of everything logged so far.
+func (l *TestLogger) Records() []CapturedRecord {
+	l.state.mu.Lock()
+	defer l.state.mu.Unlock()
+	out := make([]CapturedRecord, len(l.state.records))
+	copy(out, l.state.records)
+	return out
+}
+
+// Reset discards all captured records.
+func (l *TestLogger) Reset() {
+	l.state.mu.Lock()
+	defer l.state.mu.Unlock()
+	l.state.records = nil
+}
+
+// AssertLogged reports whether any captured record has the given level
+// and contains substr in its message.
+func (l *TestLogger) AssertLogged(level Level, substr string) bool {
+	l.state.mu.Lock()
+	defer l.state.mu.Unlock()
+	for _, r := range l.state.records {
+		if r.Level == level && strings.Contains(r.Message, substr) {
+			return true
+		}
+	}
+	return false
+}
+
+func (l *TestLogger) capture(level Level, msg string, args ...any) {
+	rec := CapturedRecord{Time: time.Now(), Level: level, Message: msg, Ctx: l.ctx}
+	rec.Attrs = append(rec.Attrs, l.attrs...)
+	rec.Attrs = append(rec.Attrs, qualifyAll(l.groups, argsToAttrs(args))...)
+	l.state.mu.Lock()
+	defer l.state.mu.Unlock()
+	l.state.records = append(l.state.records, rec)
+}
+
+// Debug implements Logger.
+func (l *TestLogger) Debug(msg string, args ...any) { l.capture(LevelDebug, msg, args...) }
+
+// Info implements Logger.
+func (l *TestLogger) Info(msg string, args ...any) { l.capture(LevelInfo, msg, args...) }
+
+// Warn implements Logger.
+func (l *TestLogger) Warn(msg string, args ...any) { l.capture(LevelWarn, msg, args...) }
+
+// Error implements Logger.
+func (l *TestLogger) Error(msg string, args ...any) { l.capture(LevelError, msg, args...) }
+
+// With implements Logger.
+func (l *TestLogger) With(args ...any) Logger {
+	clone := *l
+	clone.attrs = append(append([]slog.Attr{}, l.attrs...), qualifyAll(l.groups, argsToAttrs(args))...)
+	return &clone
+}
+
+// WithGroup implements Logger.
+func (l *TestLogger) WithGroup(name string) Logger {
+	if name == "" {
+		return l
+	}
+	clone := *l
+	clone.groups = append(append([]string{}, l.groups...), name)
+	return &clone
+}
+
+// WithError implements Logger.
+func (l *TestLogger) WithError(err error) Logger {
+	if err == nil {
+		return l
+	}
+	return l.With(Err(err))
+}
+
+// WithContext implements Logger.
+func (l *TestLogger) WithContext(ctx context.Context) Logger {
+	clone := *l
+	clone.ctx = ctx
+	return &clone
+}
+
+// argsToAttrs converts slog-style alternating key/value arguments into
+// attributes, using the same rules as slog.Record.Add.
+func argsToAttrs(args []any) []slog.Attr {
+	if len(args) == 0 {
+		return nil
+	}
+	var r slog.Record
+	r.Add(args...)
+	attrs := make([]slog.Attr, 0, r.NumAttrs())
+	r.Attrs(func(a slog.Attr) bool {
+		attrs = append(attrs, a)
+		return true
+	})
+	return attrs
+}
@@ -0,0 +1,109 @@
+package logging
+
+import (
+	"context"
+	"sync"
+	"time"
+)
+
+// onceKeys remembers which Once keys have fired; everyKeys holds the last
+// emit time per Every key. Both are process-wide, so the same key
+// throttles across logger instances.
+var (
+	onceKeys  sync.Map // key -> struct{}
+	everyMu   sync.Mutex
+	everyKeys = map[string]time.Time{}
+)
+
+// Once returns a Logger that emits at most one record for key over the
+// process lifetime, for deprecation notices and startup warnings:
+//
+//	logging.Once(logger, "old-config-format").Warn("config format v1 is deprecated")
+func Once(l Logger, key string) Logger {
+	return &gateLogger{inner: l, allow: func() bool {
+		_, seen := onceKeys.LoadOrStore(key, struct{}{})
+		return !seen
+	}}
+}
+
+// Every returns a Logger that emits at most one record for key per
+// interval, for warnings inside hot loops:
+//
+//	logging.Every(logger, time.Minute, "queue-full").Warn("queue full, dropping")
+func Every(l Logger, interval time.Duration, key string) Logger {
+	return &gateLogger{inner: l, allow: func() bool {
+		everyMu.Lock()
+		defer everyMu.Unlock()
+		now := time.Now()
+		if last, ok := everyKeys[key]; ok && now.Sub(last) < interval {
+			return false
+		}
+		everyKeys[key] = now
+		return true
+	}}
+}
+
+// Once returns a view of this logger emitting at most one record for key.
+func (l *SlogLogger) Once(key string) Logger { return Once(l, key) }
+
+// Every returns a view of this logger emitting at most one record for key
+// per interval.
+func (l *SlogLogger) Every(interval time.Duration, key string) Logger {
+	return Every(l, interval, key)
+}
+
+// gateLogger passes records through only when allow says so.
+type gateLogger struct {
+	inner Logger
+	allow func() bool
+}
+
+var _ Logger = (*gateLogger)(nil)
+
+// Debug implements Logger.
+func (g *gateLogger) Debug(msg string, args ...any) {
+	if g.allow() {
+		g.inner.Debug(msg, args...)
+	}
+}
+
+// Info implements Logger.
+func (g *gateLogger) Info(msg string, args ...any) {
+	if g.allow() {
+		g.inner.Info(msg, args...)
+	}
+}
+
+// Warn implements Logger.
+func (g *gateLogger) Warn(msg string, args ...any) {
+	if g.allow() {
+		g.inner.Warn(msg, args...)
+	}
+}
+
+// Error implements Logger.
+func (g *gateLogger) Error(msg string, args ...any) {
+	if g.allow() {
+		g.inner.Error(msg, args...)
+	}
+}
+
+// With implements Logger.
+func (g *gateLogger) With(args ...any) Logger {
+	return &gateLogger{inner: g.inner.With(args...), allow: g.allow}
+}
+
+// WithGroup implements Logger.
+func (g *gateLogger) WithGroup(name string) Logger {
+	return &gateLogger{inner: g.inner.WithGroup(name), allow: g.allow}
+}
+
+// WithError implements Logger.
+func (g *gateLogger) WithError(err error) Logger {
+	return &gateLogger{inner: g.inner.WithError(err), allow: g.allow}
+}
+
+// WithContext implements Logger.
+func (g *gateLogger) WithContext(ctx context.Context) Logger {
+	return &gateLogger{inner: g.inner.WithContext(ctx), allow: g.allow}
+}
@@ -0,0 +1,127 @@
+package logging
+
+import (
+	"fmt"
+	"log/slog"
+	"time"
+)
+
+// ZapCompat exposes a zap-shaped API over the Logger pipeline: typed
+// field constructors, Named, With and a Sugar form. The package carries
+// no zap dependency, so services migrating off zap swap the constructor
+// and their zap.String/zap.Int call sites become logging.ZapString and
+// friends with the call shapes unchanged; the records land in the same
+// rotated files as everything else.
+type ZapCompat struct {
+	l Logger
+}
+
+// NewZapCompatLogger wraps l in the zap-shaped API.
+func NewZapCompatLogger(l Logger) *ZapCompat {
+	return &ZapCompat{l: l}
+}
+
+// Zap field constructors, mirroring the zap functions of the same name.
+func ZapString(key, val string) slog.Attr        { return slog.String(key, val) }
+func ZapInt(key string, val int) slog.Attr       { return slog.Int(key, val) }
+func ZapInt64(key string, val int64) slog.Attr   { return slog.Int64(key, val) }
+func ZapFloat64(key string, v float64) slog.Attr { return slog.Float64(key, v) }
+func ZapBool(key string, val bool) slog.Attr     { return slog.Bool(key, val) }
+func ZapAny(key string, val any) slog.Attr       { return slog.Any(key, val) }
+func ZapError(err error) slog.Attr               { return Err(err) }
+func ZapDuration(key string, v time.Duration) slog.Attr {
+	return slog.Duration(key, v)
+}
+
+// Named returns a logger with name attached, matching zap.Logger.Named.
+func (z *ZapCompat) Named(name string) *ZapCompat {
+	return &ZapCompat{l: z.l.With("logger", name)}
+}
+
+// With returns a logger with the fields attached to every record.
+func (z *ZapCompat) With(fields ...slog.Attr) *ZapCompat {
+	args := make([]any, len(fields))
+	for i, f := range fields {
+		args[i] = f
+	}
+	return &ZapCompat{l: z.l.With(args...)}
+}
+
+func fieldsToArgs(fields []slog.Attr) []any {
+	args := make([]any, len(fields))
+	for i, f := range fields {
+		args[i] = f
+	}
+	return args
+}
+
+// Debug logs at LevelDebug with zap-style fields.
+func (z *ZapCompat) Debug(msg string, fields ...slog.Attr) {
+	z.l.Debug(msg, fieldsToArgs(fields)...)
+}
+
+// Info logs at LevelInfo with zap-style fields.
+func (z *ZapCompat) Info(msg string, fields ...slog.Attr) {
+	z.l.Info(msg, fieldsToArgs(fields)...)
+}
+
+// Warn logs at LevelWarn with zap-style fields.
+func (z *ZapCompat) Warn(msg string, fields ...slog.Attr) {
+	z.l.Warn(msg, fieldsToArgs(fields)...)
+}
+
+// Error logs at LevelError with zap-style fields.
+func (z *ZapCompat) Error(msg string, fields ...slog.Attr) {
+	z.l.Error(msg, fieldsToArgs(fields)...)
+}
+
+// Sugar returns the printf/loosely-typed form, matching zap's
+// SugaredLogger surface.
+func (z *ZapCompat) Sugar() *ZapSugared {
+	return &ZapSugared{l: z.l}
+}
+
+// ZapSugared mirrors zap.SugaredLogger's printf-style and keyed methods.
+type ZapSugared struct {
+	l Logger
+}
+
+// Debugf logs a formatted message at LevelDebug.
+func (s *ZapSugared) Debugf(format string, args ...any) {
+	s.l.Debug(fmt.Sprintf(format, args...))
+}
+
+// Infof logs a formatted message at LevelInfo.
+func (s *ZapSugared) Infof(format string, args ...any) {
+	s.l.Info(fmt.Sprintf(format, args...))
+}
+
+// Warnf logs a formatted message at LevelWarn.
+func (s *ZapSugared) Warnf(format string, args ...any) {
+	s.l.Warn(fmt.Sprintf(format, args...))
+}
+
+// Errorf logs a formatted message at LevelError.
+func (s *ZapSugared) Errorf(format string, args ...any) {
+	s.l.Error(fmt.Sprintf(format, args...))
+}
+
+// Debugw logs a message with alternating key/value pairs at LevelDebug.
+func (s *ZapSugared) Debugw(msg string, keysAndValues ...any) {
+	s.l.Debug(msg, keysAndValues...)
+}
+
+// Infow logs a message with alternating key/value pairs at LevelInfo.
+func (s *ZapSugared) Infow(msg string, keysAndValues ...any) {
+	s.l.Info(msg, keysAndValues...)
+}
+
+// Warnw logs a message with alternating key/value pairs at LevelWarn.
+func (s *ZapSugared) Warnw(msg string, keysAndValues ...any) {
+	s.l.Warn(msg, keysAndValues...)
+}
+
+// Errorw logs a message with alternating key/value pairs at LevelError.
+func (s *ZapSugared) Errorw(msg string, keysAndValues ...any) {
+	s.l.Error(msg, keysAndValues...)
+}